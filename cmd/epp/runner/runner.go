@@ -159,6 +159,7 @@ func (r *Runner) Run(ctx context.Context) error {
 	setupLog.Info(r.eppExecutableName+" build", "commit-sha", version.CommitSHA, "build-ref", version.BuildRef)
 
 	opts := runserver.NewOptions()
+	opts.DeprecatedFlagHook = metrics.RecordDeprecatedFlagUsed
 	opts.AddFlags(pflag.CommandLine)
 	pflag.Parse()
 
@@ -264,6 +265,10 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 
 	// --- Setup Metrics Server ---
 	r.customCollectors = append(r.customCollectors, collectors.NewInferencePoolMetricsCollector(ds))
+	if err := metrics.SetDatalayerDispatchLatencyBuckets(opts.DatalayerDispatchLatencyBuckets); err != nil {
+		setupLog.Error(err, "Failed to configure metrics")
+		return nil, nil, err
+	}
 	metrics.Register(r.customCollectors...)
 	metrics.RecordInferenceExtensionInfo(version.CommitSHA, version.BuildRef)
 	// Register metrics handler.
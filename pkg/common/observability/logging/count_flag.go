@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"strconv"
+
+	"github.com/spf13/pflag"
+)
+
+// countIncrement is the value pflag passes to Set for a bare occurrence of a
+// count flag.
+const countIncrement = "+1"
+
+// countValue is a pflag.Value for repeatable flags, incrementing the bound int on
+// every bare occurrence (e.g., "-v -v -v" or "-vvv").
+type countValue int
+
+var _ pflag.Value = (*countValue)(nil)
+
+// NewCountFlag returns a flag value bound to p, seeded with def, which increments
+// p on every bare occurrence of the flag. An explicit value (e.g., "--v=3") sets
+// the count instead, so existing scripts passing numbers keep working. Register
+// it with AddCountFlag, which configures the bare form.
+func NewCountFlag(p *int, def int) pflag.Value {
+	*p = def
+	return (*countValue)(p)
+}
+
+func (c *countValue) Set(s string) error {
+	if s == countIncrement {
+		*c++
+		return nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*c = countValue(v)
+	return nil
+}
+
+func (c *countValue) Type() string {
+	return "count"
+}
+
+func (c *countValue) String() string {
+	return strconv.Itoa(int(*c))
+}
+
+// AddCountFlag registers a count flag bound to p on fs, starting at def.
+//
+// Note that a bare count flag does not consume the following argument, so
+// "--v 3" parses as an increment followed by a positional "3". Flags that accept
+// space-separated values today (such as the -v flag of LoggingOptions) can't be
+// converted without breaking their users.
+func AddCountFlag(fs *pflag.FlagSet, p *int, name, shorthand string, def int, usage string) {
+	flag := fs.VarPF(NewCountFlag(p, def), name, shorthand, usage)
+	flag.NoOptDefVal = countIncrement
+}
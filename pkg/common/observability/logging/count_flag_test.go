@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestCountFlag(t *testing.T) {
+	tests := []struct {
+		name string
+		def  int
+		args []string
+		want int
+	}{
+		{name: "unset keeps default", def: 2, args: nil, want: 2},
+		{name: "three occurrences", def: 0, args: []string{"-c", "-c", "-c"}, want: 3},
+		{name: "combined shorthand", def: 0, args: []string{"-ccc"}, want: 3},
+		{name: "default seeds count", def: 2, args: []string{"--count", "-c"}, want: 4},
+		{name: "explicit value sets count", def: 2, args: []string{"--count=5"}, want: 5},
+		{name: "increment after explicit value", def: 0, args: []string{"--count=5", "-c"}, want: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var count int
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			AddCountFlag(fs, &count, "count", "c", tt.def, "count flag")
+
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			if count != tt.want {
+				t.Errorf("expected count %d, got %d", tt.want, count)
+			}
+		})
+	}
+}
+
+func TestCountFlagInvalidValue(t *testing.T) {
+	var count int
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddCountFlag(fs, &count, "count", "c", 0, "count flag")
+
+	if err := fs.Parse([]string{"--count=high"}); err == nil {
+		t.Error("expected an error for a non-numeric value")
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"github.com/go-logr/logr"
+)
+
+// RedactedValue replaces the value of sensitive keys in redacted log lines.
+const RedactedValue = "***"
+
+// Redacting returns a logger that replaces the values of the given keys with
+// RedactedValue before they reach the underlying sink. Keys are matched exactly
+// and apply to values passed to Info, Error and WithValues.
+func Redacting(logger logr.Logger, sensitiveKeys ...string) logr.Logger {
+	sink := logger.GetSink()
+	if sink == nil || len(sensitiveKeys) == 0 {
+		return logger
+	}
+	keys := make(map[string]struct{}, len(sensitiveKeys))
+	for _, k := range sensitiveKeys {
+		keys[k] = struct{}{}
+	}
+	return logr.New(&redactingSink{sink: sink, keys: keys})
+}
+
+// redactingSink is a logr.LogSink scrubbing sensitive values from keysAndValues.
+type redactingSink struct {
+	sink logr.LogSink
+	keys map[string]struct{}
+}
+
+var (
+	_ logr.LogSink          = (*redactingSink)(nil)
+	_ logr.CallDepthLogSink = (*redactingSink)(nil)
+)
+
+func (s *redactingSink) Init(info logr.RuntimeInfo) {
+	info.CallDepth++ // account for the wrapper frame
+	s.sink.Init(info)
+}
+
+func (s *redactingSink) Enabled(level int) bool {
+	return s.sink.Enabled(level)
+}
+
+func (s *redactingSink) Info(level int, msg string, keysAndValues ...any) {
+	s.sink.Info(level, msg, s.redact(keysAndValues)...)
+}
+
+func (s *redactingSink) Error(err error, msg string, keysAndValues ...any) {
+	s.sink.Error(err, msg, s.redact(keysAndValues)...)
+}
+
+func (s *redactingSink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &redactingSink{sink: s.sink.WithValues(s.redact(keysAndValues)...), keys: s.keys}
+}
+
+func (s *redactingSink) WithName(name string) logr.LogSink {
+	return &redactingSink{sink: s.sink.WithName(name), keys: s.keys}
+}
+
+func (s *redactingSink) WithCallDepth(depth int) logr.LogSink {
+	if cd, ok := s.sink.(logr.CallDepthLogSink); ok {
+		return &redactingSink{sink: cd.WithCallDepth(depth), keys: s.keys}
+	}
+	return s
+}
+
+// redact returns keysAndValues with sensitive values replaced. The input slice
+// is never modified since it may be owned by the caller.
+func (s *redactingSink) redact(keysAndValues []any) []any {
+	var result []any
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		key, ok := keysAndValues[i].(string)
+		if !ok {
+			continue
+		}
+		if _, sensitive := s.keys[key]; !sensitive {
+			continue
+		}
+		if result == nil { // copy on first write
+			result = append([]any(nil), keysAndValues...)
+		}
+		result[i+1] = RedactedValue
+	}
+	if result == nil {
+		return keysAndValues
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+)
+
+// newCaptureLogger returns a funcr logger appending every rendered line to lines.
+func newCaptureLogger(lines *[]string) logr.Logger {
+	return funcr.New(func(prefix, args string) {
+		*lines = append(*lines, prefix+" "+args)
+	}, funcr.Options{Verbosity: TRACE})
+}
+
+func TestRedacting(t *testing.T) {
+	var lines []string
+	logger := Redacting(newCaptureLogger(&lines), "token", "authorization")
+
+	kv := []any{"token", "s3cr3t", "user", "bob"}
+	logger.Info("info line", kv...)
+	logger.Error(errors.New("boom"), "error line", "authorization", "Bearer abc")
+	logger.WithValues("token", "s3cr3t").WithName("child").Info("with values")
+
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 log lines, got %d: %v", len(lines), lines)
+	}
+	for _, line := range lines {
+		if strings.Contains(line, "s3cr3t") || strings.Contains(line, "Bearer abc") {
+			t.Errorf("sensitive value leaked: %s", line)
+		}
+	}
+	if !strings.Contains(lines[0], `"token"="***"`) || !strings.Contains(lines[0], `"user"="bob"`) {
+		t.Errorf("unexpected info line: %s", lines[0])
+	}
+	if !strings.Contains(lines[1], `"authorization"="***"`) {
+		t.Errorf("unexpected error line: %s", lines[1])
+	}
+	if !strings.Contains(lines[2], `"token"="***"`) {
+		t.Errorf("unexpected WithValues line: %s", lines[2])
+	}
+	if kv[1] != "s3cr3t" {
+		t.Errorf("caller's keysAndValues were modified: %v", kv)
+	}
+}
+
+func TestRedactingNoKeys(t *testing.T) {
+	var lines []string
+	logger := Redacting(newCaptureLogger(&lines))
+
+	logger.Info("line", "token", "visible")
+	if len(lines) != 1 || !strings.Contains(lines[0], `"token"="visible"`) {
+		t.Errorf("expected values to pass through unchanged, got %v", lines)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"sync"
+
+	"github.com/go-logr/logr"
+)
+
+// SuppressedMessage is the message of the summary line emitted by Sampled loggers.
+const SuppressedMessage = "suppressed similar messages"
+
+// Sampled returns a logger forwarding only the first of every `every` identical
+// messages (same message string and Info/Error kind). Before forwarding a sampled
+// message, a summary line reports how many copies were suppressed since the last
+// one. Loggers derived via WithValues/WithName share the sampling state.
+// Values of every <= 1 disable sampling.
+func Sampled(logger logr.Logger, every int) logr.Logger {
+	sink := logger.GetSink()
+	if sink == nil || every <= 1 {
+		return logger
+	}
+	return logr.New(&sampledSink{
+		sink:  sink,
+		state: &samplingState{every: every, counts: make(map[samplingKey]int)},
+	})
+}
+
+type samplingKey struct {
+	isError bool
+	msg     string
+}
+
+// samplingState counts occurrences per message. Messages are expected to be
+// static strings, so the map stays small.
+type samplingState struct {
+	every  int
+	mu     sync.Mutex
+	counts map[samplingKey]int
+}
+
+// sample records an occurrence and reports whether it should be forwarded, along
+// with the number of copies suppressed since the previous forwarded one.
+func (s *samplingState) sample(key samplingKey) (bool, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := s.counts[key]
+	s.counts[key] = n + 1
+	if n%s.every != 0 {
+		return false, 0
+	}
+	if n == 0 {
+		return true, 0
+	}
+	return true, s.every - 1
+}
+
+// sampledSink is a logr.LogSink forwarding 1-in-N identical messages.
+type sampledSink struct {
+	sink  logr.LogSink
+	state *samplingState
+}
+
+var (
+	_ logr.LogSink          = (*sampledSink)(nil)
+	_ logr.CallDepthLogSink = (*sampledSink)(nil)
+)
+
+func (s *sampledSink) Init(info logr.RuntimeInfo) {
+	info.CallDepth++ // account for the wrapper frame
+	s.sink.Init(info)
+}
+
+func (s *sampledSink) Enabled(level int) bool {
+	return s.sink.Enabled(level)
+}
+
+func (s *sampledSink) Info(level int, msg string, keysAndValues ...any) {
+	forward, suppressed := s.state.sample(samplingKey{msg: msg})
+	if !forward {
+		return
+	}
+	if suppressed > 0 {
+		s.sink.Info(level, SuppressedMessage, "message", msg, "count", suppressed)
+	}
+	s.sink.Info(level, msg, keysAndValues...)
+}
+
+func (s *sampledSink) Error(err error, msg string, keysAndValues ...any) {
+	forward, suppressed := s.state.sample(samplingKey{isError: true, msg: msg})
+	if !forward {
+		return
+	}
+	if suppressed > 0 {
+		s.sink.Info(0, SuppressedMessage, "message", msg, "count", suppressed)
+	}
+	s.sink.Error(err, msg, keysAndValues...)
+}
+
+func (s *sampledSink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &sampledSink{sink: s.sink.WithValues(keysAndValues...), state: s.state}
+}
+
+func (s *sampledSink) WithName(name string) logr.LogSink {
+	return &sampledSink{sink: s.sink.WithName(name), state: s.state}
+}
+
+func (s *sampledSink) WithCallDepth(depth int) logr.LogSink {
+	if cd, ok := s.sink.(logr.CallDepthLogSink); ok {
+		return &sampledSink{sink: cd.WithCallDepth(depth), state: s.state}
+	}
+	return s
+}
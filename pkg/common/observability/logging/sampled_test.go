@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSampled(t *testing.T) {
+	var lines []string
+	logger := Sampled(newCaptureLogger(&lines), 10)
+
+	err := errors.New("extract failed")
+	for range 25 {
+		logger.Error(err, "extractor failed")
+	}
+	logger.Info("other message") // distinct messages are sampled independently
+
+	forwarded, summaries := 0, 0
+	for _, line := range lines {
+		switch {
+		case strings.Contains(line, SuppressedMessage):
+			summaries++
+			if !strings.Contains(line, `"count"=9`) {
+				t.Errorf("unexpected summary line: %s", line)
+			}
+		case strings.Contains(line, "extractor failed"):
+			forwarded++
+		}
+	}
+	// occurrences 1, 11 and 21 are forwarded; the last two are preceded by a summary.
+	if forwarded != 3 {
+		t.Errorf("expected 3 forwarded messages, got %d: %v", forwarded, lines)
+	}
+	if summaries != 2 {
+		t.Errorf("expected 2 summary messages, got %d: %v", summaries, lines)
+	}
+	if !strings.Contains(lines[len(lines)-1], "other message") {
+		t.Errorf("expected distinct message to be forwarded, got %v", lines)
+	}
+}
+
+func TestSampledSharedState(t *testing.T) {
+	var lines []string
+	logger := Sampled(newCaptureLogger(&lines), 2)
+
+	logger.Info("msg")
+	logger.WithValues("k", "v").Info("msg") // suppressed: same message on a derived logger
+	if len(lines) != 1 {
+		t.Errorf("expected derived logger to share sampling state, got %v", lines)
+	}
+}
+
+func TestSampledDisabled(t *testing.T) {
+	var lines []string
+	logger := Sampled(newCaptureLogger(&lines), 1)
+
+	for range 5 {
+		logger.Info("msg")
+	}
+	if len(lines) != 5 {
+		t.Errorf("expected all messages with sampling disabled, got %d", len(lines))
+	}
+}
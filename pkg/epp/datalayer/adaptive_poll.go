@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// errPollBackedOff is returned by adaptive sources for polls skipped while an
+// endpoint is backed off.
+var errPollBackedOff = fmt.Errorf("endpoint poll backed off: %w", fwkdl.ErrSkip)
+
+// WithAdaptiveInterval wraps a polling source so that an endpoint failing to be
+// polled is polled less often: every consecutive failure doubles its interval,
+// starting from base (the Runtime polling interval) up to max, or uncapped if
+// max <= 0. The first success restores the base interval. Polls are still driven
+// by the Runtime ticks, so the effective interval is rounded up to a multiple of
+// base. Intervals are measured with the wall clock, unless set with WithClock.
+// As with WithEndpointTimeout, other optional interfaces of src are not visible
+// through the wrapper.
+func WithAdaptiveInterval(src fwkdl.PollingDataSource, base, max time.Duration, opts ...ClockOption) fwkdl.PollingDataSource {
+	return &adaptiveSource{
+		PollingDataSource: src,
+		base:              base,
+		max:               max,
+		clock:             clockOf(opts),
+		failing:           make(map[string]*endpointBackoff),
+	}
+}
+
+// endpointBackoff is the polling state of a failing endpoint.
+type endpointBackoff struct {
+	backoff *Backoff
+	next    time.Time // earliest time of the next poll
+}
+
+type adaptiveSource struct {
+	fwkdl.PollingDataSource
+	base  time.Duration
+	max   time.Duration
+	clock Clock
+
+	mu      sync.Mutex
+	failing map[string]*endpointBackoff // key=EndpointKey; endpoints polled successfully are dropped
+}
+
+func (s *adaptiveSource) Poll(ctx context.Context, ep fwkdl.Endpoint) (any, error) {
+	key := fwkdl.EndpointKey(ep)
+	if s.backedOff(key) {
+		return nil, errPollBackedOff
+	}
+	data, err := s.PollingDataSource.Poll(ctx, ep)
+	s.observe(key, err)
+	return data, err
+}
+
+func (s *adaptiveSource) backedOff(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.failing[key]
+	return ok && s.clock.Now().Before(state.next)
+}
+
+func (s *adaptiveSource) observe(key string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		delete(s.failing, key)
+		return
+	}
+	state, ok := s.failing[key]
+	if !ok {
+		state = &endpointBackoff{backoff: NewBackoff(2*s.base, s.max, 2, false)}
+		s.failing[key] = state
+	}
+	state.next = s.clock.Now().Add(state.backoff.Next())
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testclock "k8s.io/utils/clock/testing"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+func TestAdaptiveIntervalBacksOff(t *testing.T) {
+	clk := testclock.NewFakeClock(time.Now())
+	src := &errSource{}
+	src.setErr(errors.New("connection refused"))
+	wrapped := WithAdaptiveInterval(src, time.Second, 4*time.Second, WithClock(clk))
+
+	ctx := context.Background()
+	calls := func() int64 { return atomic.LoadInt64(&src.CallCount) }
+	// tick polls the endpoint once a second for the given duration and returns
+	// the number of polls that reached the source.
+	tick := func(d time.Duration) int64 {
+		before := calls()
+		for range int(d / time.Second) {
+			clk.Step(time.Second)
+			_, _ = wrapped.Poll(ctx, endpoint)
+		}
+		return calls() - before
+	}
+
+	_, err := wrapped.Poll(ctx, endpoint)
+	require.Error(t, err)
+	require.EqualValues(t, 1, calls())
+
+	_, err = wrapped.Poll(ctx, endpoint)
+	assert.ErrorIs(t, err, fwkdl.ErrSkip, "polls within the backoff interval should be skipped")
+	assert.EqualValues(t, 1, calls())
+
+	assert.EqualValues(t, 1, tick(2*time.Second), "interval should double to 2s after the first failure")
+	assert.EqualValues(t, 1, tick(4*time.Second), "interval should double to 4s after the second failure")
+	assert.EqualValues(t, 2, tick(8*time.Second), "interval should be capped at 4s")
+
+	src.setErr(nil)
+	assert.EqualValues(t, 1, tick(4*time.Second), "a succeeding poll ends the backoff")
+	assert.EqualValues(t, 3, tick(3*time.Second), "interval should reset to base after recovery")
+}
+
+func TestAdaptiveIntervalPerEndpoint(t *testing.T) {
+	clk := testclock.NewFakeClock(time.Now())
+	src := &errSource{}
+	src.setErr(errors.New("connection refused"))
+	wrapped := WithAdaptiveInterval(src, time.Second, 0, WithClock(clk))
+
+	ctx := context.Background()
+	_, err := wrapped.Poll(ctx, newLabeledEndpoint("failing", nil))
+	require.Error(t, err)
+	src.setErr(nil)
+
+	_, err = wrapped.Poll(ctx, newLabeledEndpoint("healthy", nil))
+	assert.NoError(t, err, "backing off an endpoint should not affect the others")
+	_, err = wrapped.Poll(ctx, newLabeledEndpoint("failing", nil))
+	assert.ErrorIs(t, err, fwkdl.ErrSkip)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+const defaultBackoffMultiplier = 2.0
+
+// Backoff computes exponentially growing delays, starting at Base and multiplied
+// by Multiplier on every call to Next, capped at Max. With Jitter enabled, Next
+// returns a uniformly random delay between zero and the current delay ("full
+// jitter"), which spreads out retries of many clients failing at the same time.
+// Backoff is not safe for concurrent use.
+type Backoff struct {
+	Base       time.Duration // first delay returned by Next
+	Max        time.Duration // upper bound on any delay; <= 0 means uncapped
+	Multiplier float64       // growth factor between delays; values <= 1 use a default of 2
+	Jitter     bool          // enables full jitter
+
+	current time.Duration // last un-jittered delay; zero before the first Next
+}
+
+// NewBackoff returns a Backoff with the given parameters.
+func NewBackoff(base, max time.Duration, multiplier float64, jitter bool) *Backoff {
+	return &Backoff{
+		Base:       base,
+		Max:        max,
+		Multiplier: multiplier,
+		Jitter:     jitter,
+	}
+}
+
+// Next returns the next delay and advances the backoff.
+func (b *Backoff) Next() time.Duration {
+	if b.current == 0 {
+		b.current = b.Base
+	} else {
+		multiplier := b.Multiplier
+		if multiplier <= 1 {
+			multiplier = defaultBackoffMultiplier
+		}
+		next := float64(b.current) * multiplier
+		if b.Max > 0 && next > float64(b.Max) {
+			b.current = b.Max
+		} else {
+			b.current = time.Duration(next)
+		}
+	}
+	if b.Max > 0 && b.current > b.Max {
+		b.current = b.Max
+	}
+
+	if !b.Jitter || b.current <= 0 {
+		return b.current
+	}
+	return time.Duration(rand.Int64N(int64(b.current) + 1))
+}
+
+// Reset returns the backoff to its initial state, so the following Next returns
+// (at most) Base again.
+func (b *Backoff) Reset() {
+	b.current = 0
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffGrowthCappedAtMax(t *testing.T) {
+	b := NewBackoff(100*time.Millisecond, time.Second, 2, false)
+
+	want := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for i, w := range want {
+		assert.Equal(t, w, b.Next(), "delay %d", i)
+	}
+
+	b.Reset()
+	assert.Equal(t, 100*time.Millisecond, b.Next(), "expected Reset to restart from base")
+}
+
+func TestBackoffDefaultMultiplier(t *testing.T) {
+	b := NewBackoff(time.Second, 0, 0, false)
+	assert.Equal(t, time.Second, b.Next())
+	assert.Equal(t, 2*time.Second, b.Next())
+	assert.Equal(t, 4*time.Second, b.Next(), "expected uncapped growth with Max <= 0")
+}
+
+func TestBackoffJitterWithinBounds(t *testing.T) {
+	jittered := NewBackoff(10*time.Millisecond, 500*time.Millisecond, 1.5, true)
+	plain := NewBackoff(10*time.Millisecond, 500*time.Millisecond, 1.5, false)
+
+	for i := range 50 {
+		upper := plain.Next()
+		got := jittered.Next()
+		assert.GreaterOrEqual(t, got, time.Duration(0), "delay %d", i)
+		assert.LessOrEqual(t, got, upper, "delay %d", i)
+	}
+}
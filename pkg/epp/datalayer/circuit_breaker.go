@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+const (
+	defaultCircuitFailureThreshold = 5
+	defaultCircuitCooldown         = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned by circuit breaker extractors instead of invoking
+// the wrapped extractor while the circuit is open. It wraps fwkdl.ErrSkip, so the
+// Runtime does not log short-circuited calls as failures.
+var ErrCircuitOpen = fmt.Errorf("circuit breaker open: %w", fwkdl.ErrSkip)
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed passes calls through to the wrapped extractor.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen short-circuits calls with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe call through to test for recovery.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreakerConfig configures a circuit breaker extractor.
+type CircuitBreakerConfig struct {
+	FailureThreshold int           // consecutive failures opening the circuit; <= 0 uses a default of 5
+	Cooldown         time.Duration // time spent open before probing; <= 0 uses a default of 30s
+	// OnStateChange, if set, is called on every state transition. It is called
+	// synchronously by the extracting goroutine, so it should return quickly.
+	OnStateChange func(name string, from, to CircuitState)
+	Clock         Clock // time source; defaults to the wall clock
+}
+
+// CircuitBreakerExtractor decorates an Extractor whose downstream may fail
+// persistently. After FailureThreshold consecutive failures the circuit opens and
+// calls return ErrCircuitOpen without invoking the extractor. Once Cooldown
+// elapses, the next call is let through as a probe: success closes the circuit,
+// failure opens it again. Returning fwkdl.ErrSkip is not counted as a failure.
+type CircuitBreakerExtractor struct {
+	fwkdl.Extractor
+	cfg CircuitBreakerConfig
+
+	mu         sync.Mutex
+	state      CircuitState
+	generation uint64    // incremented on every state change
+	failures   int       // consecutive failures while closed
+	openedAt   time.Time // when the circuit last opened
+	probing    bool      // a half-open probe is in flight
+}
+
+// NewCircuitBreakerExtractor wraps ext with a circuit breaker.
+func NewCircuitBreakerExtractor(ext fwkdl.Extractor, cfg CircuitBreakerConfig) *CircuitBreakerExtractor {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = defaultCircuitFailureThreshold
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = defaultCircuitCooldown
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = defaultClock
+	}
+	return &CircuitBreakerExtractor{Extractor: ext, cfg: cfg}
+}
+
+// State returns the current circuit state. An open circuit whose cooldown has
+// elapsed reports open until the next call probes it.
+func (cb *CircuitBreakerExtractor) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state
+}
+
+// DependsOn implements fwkdl.DependentExtractor.
+func (cb *CircuitBreakerExtractor) DependsOn() []string {
+	return dependsOnOf(cb.Extractor)
+}
+
+// Extract invokes the wrapped extractor unless the circuit is open.
+func (cb *CircuitBreakerExtractor) Extract(ctx context.Context, data any, ep fwkdl.Endpoint) error {
+	return cb.call(func() error { return cb.Extractor.Extract(ctx, data, ep) })
+}
+
+func (cb *CircuitBreakerExtractor) call(extract func() error) error {
+	generation, probe, allowed := cb.allow()
+	if !allowed {
+		return ErrCircuitOpen
+	}
+	err := extract()
+	cb.record(generation, probe, err == nil || errors.Is(err, fwkdl.ErrSkip))
+	return err
+}
+
+// allow reports whether a call may proceed, moving an open circuit whose
+// cooldown elapsed to half-open. It returns the generation of the state the call
+// started in, and whether the call is the half-open probe.
+func (cb *CircuitBreakerExtractor) allow() (generation uint64, probe, allowed bool) {
+	cb.mu.Lock()
+	var transition func()
+	allowed = true
+	switch cb.state {
+	case CircuitOpen:
+		if cb.cfg.Clock.Now().Sub(cb.openedAt) < cb.cfg.Cooldown {
+			allowed = false
+			break
+		}
+		transition = cb.setState(CircuitHalfOpen)
+		cb.probing, probe = true, true
+	case CircuitHalfOpen:
+		allowed = !cb.probing // a single probe at a time
+		cb.probing, probe = true, allowed
+	}
+	generation = cb.generation
+	cb.mu.Unlock()
+
+	if transition != nil {
+		transition()
+	}
+	return generation, probe, allowed
+}
+
+// record updates the circuit with the outcome of a call. Calls that started in
+// an earlier state, e.g., closed calls finishing once the circuit opened, are
+// ignored: only the probe decides the fate of a half-open circuit.
+func (cb *CircuitBreakerExtractor) record(generation uint64, probe, success bool) {
+	cb.mu.Lock()
+	var transition func()
+	switch {
+	case generation != cb.generation:
+	case probe:
+		cb.probing = false
+		if success {
+			cb.failures = 0
+			transition = cb.setState(CircuitClosed)
+		} else {
+			cb.openedAt = cb.cfg.Clock.Now()
+			transition = cb.setState(CircuitOpen)
+		}
+	case success:
+		cb.failures = 0
+	default:
+		cb.failures++
+		if cb.state == CircuitClosed && cb.failures >= cb.cfg.FailureThreshold {
+			cb.openedAt = cb.cfg.Clock.Now()
+			transition = cb.setState(CircuitOpen)
+		}
+	}
+	cb.mu.Unlock()
+
+	if transition != nil {
+		transition()
+	}
+}
+
+// setState changes the state and returns the notification to run once the lock
+// is released, if any. Must be called with the lock held.
+func (cb *CircuitBreakerExtractor) setState(to CircuitState) func() {
+	from := cb.state
+	cb.state = to
+	if from != to {
+		cb.generation++
+	}
+	if cb.cfg.OnStateChange == nil || from == to {
+		return nil
+	}
+	name := cb.TypedName().String()
+	return func() { cb.cfg.OnStateChange(name, from, to) }
+}
+
+// NotificationCircuitBreakerExtractor is a CircuitBreakerExtractor for
+// NotificationExtractors.
+type NotificationCircuitBreakerExtractor struct {
+	*CircuitBreakerExtractor
+	notifier fwkdl.NotificationExtractor
+}
+
+var _ fwkdl.NotificationExtractor = (*NotificationCircuitBreakerExtractor)(nil)
+
+// NewNotificationCircuitBreakerExtractor wraps a NotificationExtractor with a
+// circuit breaker covering both Extract and ExtractNotification.
+func NewNotificationCircuitBreakerExtractor(ext fwkdl.NotificationExtractor,
+	cfg CircuitBreakerConfig) *NotificationCircuitBreakerExtractor {
+	return &NotificationCircuitBreakerExtractor{
+		CircuitBreakerExtractor: NewCircuitBreakerExtractor(ext, cfg),
+		notifier:                ext,
+	}
+}
+
+// GVK returns the GroupVersionKind of the wrapped extractor.
+func (cb *NotificationCircuitBreakerExtractor) GVK() schema.GroupVersionKind {
+	return cb.notifier.GVK()
+}
+
+// ExtractNotification invokes the wrapped extractor unless the circuit is open.
+func (cb *NotificationCircuitBreakerExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	return cb.call(func() error { return cb.notifier.ExtractNotification(ctx, event) })
+}
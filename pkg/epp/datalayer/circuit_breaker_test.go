@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	testclock "k8s.io/utils/clock/testing"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+)
+
+func TestCircuitBreakerExtractorTransitions(t *testing.T) {
+	clk := testclock.NewFakeClock(time.Now())
+	var transitions []string
+	inner := extractormocks.NewPollingExtractor("flaky").WithExtractError(errors.New("downstream unavailable"))
+	cb := NewCircuitBreakerExtractor(inner, CircuitBreakerConfig{
+		FailureThreshold: 3,
+		Cooldown:         10 * time.Second,
+		Clock:            clk,
+		OnStateChange: func(name string, from, to CircuitState) {
+			transitions = append(transitions, fmt.Sprintf("%s:%s->%s", name, from, to))
+		},
+	})
+	ctx := context.Background()
+
+	// closed: failures are passed through until the threshold is reached.
+	for range 3 {
+		assert.NotErrorIs(t, cb.Extract(ctx, nil, endpoint), ErrCircuitOpen)
+	}
+	assert.Equal(t, CircuitOpen, cb.State())
+	assert.Equal(t, 3, inner.CallCount())
+
+	// open: calls are short-circuited with a skip.
+	err := cb.Extract(ctx, nil, endpoint)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.ErrorIs(t, err, fwkdl.ErrSkip)
+	assert.Equal(t, 3, inner.CallCount(), "open circuit must not invoke the extractor")
+
+	// half-open: a failed probe re-opens the circuit for another cooldown.
+	clk.Step(10 * time.Second)
+	assert.NotErrorIs(t, cb.Extract(ctx, nil, endpoint), ErrCircuitOpen)
+	assert.Equal(t, CircuitOpen, cb.State())
+	assert.Equal(t, 4, inner.CallCount())
+	assert.ErrorIs(t, cb.Extract(ctx, nil, endpoint), ErrCircuitOpen)
+
+	// half-open: a successful probe closes the circuit.
+	inner.WithExtractError(nil)
+	clk.Step(10 * time.Second)
+	require.NoError(t, cb.Extract(ctx, nil, endpoint))
+	assert.Equal(t, CircuitClosed, cb.State())
+	require.NoError(t, cb.Extract(ctx, nil, endpoint))
+	assert.Equal(t, 6, inner.CallCount())
+
+	assert.Equal(t, []string{
+		"flaky/mock-extractor:closed->open",
+		"flaky/mock-extractor:open->half-open",
+		"flaky/mock-extractor:half-open->open",
+		"flaky/mock-extractor:open->half-open",
+		"flaky/mock-extractor:half-open->closed",
+	}, transitions)
+}
+
+func TestCircuitBreakerExtractorResetsOnSuccess(t *testing.T) {
+	inner := extractormocks.NewPollingExtractor("flaky")
+	cb := NewCircuitBreakerExtractor(inner, CircuitBreakerConfig{FailureThreshold: 2})
+	ctx := context.Background()
+
+	for range 3 { // failures are not consecutive, so the circuit stays closed
+		inner.WithExtractError(errors.New("boom"))
+		_ = cb.Extract(ctx, nil, endpoint)
+		inner.WithExtractError(fwkdl.ErrSkip) // skips count as successes
+		_ = cb.Extract(ctx, nil, endpoint)
+	}
+	assert.Equal(t, CircuitClosed, cb.State())
+	assert.Equal(t, 6, inner.CallCount())
+}
+
+func TestCircuitBreakerExtractorIgnoresStaleCalls(t *testing.T) {
+	clk := testclock.NewFakeClock(time.Now())
+	cb := NewCircuitBreakerExtractor(extractormocks.NewPollingExtractor("flaky"),
+		CircuitBreakerConfig{FailureThreshold: 2, Cooldown: time.Second, Clock: clk})
+	boom := errors.New("boom")
+
+	// blocked starts a call, returning err once released.
+	blocked := func(err error) (release func(), done <-chan struct{}) {
+		started, unblock, finished := make(chan struct{}), make(chan struct{}), make(chan struct{})
+		go func() {
+			defer close(finished)
+			_ = cb.call(func() error {
+				close(started)
+				<-unblock
+				return err
+			})
+		}()
+		<-started
+		return func() { close(unblock) }, finished
+	}
+
+	releaseClosed, closedDone := blocked(nil) // started while closed
+	for range 2 {
+		_ = cb.call(func() error { return boom })
+	}
+	require.Equal(t, CircuitOpen, cb.State())
+	clk.Step(time.Second)
+	releaseProbe, probeDone := blocked(boom)
+	require.Equal(t, CircuitHalfOpen, cb.State())
+
+	releaseClosed()
+	<-closedDone
+	assert.Equal(t, CircuitHalfOpen, cb.State(), "a call started while closed should not decide the probe")
+	assert.ErrorIs(t, cb.call(func() error { return nil }), ErrCircuitOpen, "the probe should still be in flight")
+
+	releaseProbe()
+	<-probeDone
+	assert.Equal(t, CircuitOpen, cb.State(), "the failed probe should re-open the circuit")
+}
+
+func TestNotificationCircuitBreakerExtractor(t *testing.T) {
+	inner := extractormocks.NewNotificationExtractor("notify").WithExtractError(errors.New("boom"))
+	cb := NewNotificationCircuitBreakerExtractor(inner, CircuitBreakerConfig{FailureThreshold: 1})
+	event := fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}}
+
+	assert.Equal(t, inner.GVK(), cb.GVK())
+	assert.Equal(t, inner.TypedName(), cb.TypedName())
+	assert.NotErrorIs(t, cb.ExtractNotification(context.Background(), event), ErrCircuitOpen)
+	assert.ErrorIs(t, cb.ExtractNotification(context.Background(), event), ErrCircuitOpen)
+	assert.Len(t, inner.GetEvents(), 1)
+}
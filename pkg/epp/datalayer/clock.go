@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// Clock is the time source of the data layer's time-dependent helpers. It is a
+// subset of k8s.io/utils/clock.Clock: production code uses clock.RealClock and
+// tests can control time with k8s.io/utils/clock/testing.FakeClock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current time on
+	// the returned channel.
+	After(d time.Duration) <-chan time.Time
+	// NewTimer returns a Timer firing once after the duration.
+	NewTimer(d time.Duration) clock.Timer
+}
+
+// defaultClock is the wall clock used unless a test overrides it.
+var defaultClock Clock = clock.RealClock{}
+
+// ClockOption configures the time source of a data layer helper.
+type ClockOption func(*Clock)
+
+// WithClock makes a helper measure time with clock instead of the wall clock.
+func WithClock(clock Clock) ClockOption {
+	return func(c *Clock) {
+		*c = clock
+	}
+}
+
+// clockOf returns the time source configured by opts.
+func clockOf(opts []ClockOption) Clock {
+	clock := defaultClock
+	for _, opt := range opts {
+		opt(&clock)
+	}
+	return clock
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// CollectResult is the outcome of collecting data from one endpoint.
+type CollectResult struct {
+	Endpoint fwkdl.Endpoint
+	Err      error         // poll or extraction failure; nil on success, including deliberate skips
+	Duration time.Duration // time spent polling the endpoint and running the extractors
+}
+
+// ErrCollectCancelled is reported for the endpoints of a batch cancelled with
+// CollectBatch.Cancel.
+var ErrCollectCancelled = errors.New("endpoint collection cancelled")
+
+// CollectAll polls all endpoints from src concurrently, outside of the periodic
+// collection, and runs the extractors on the data of each, e.g., to refresh
+// endpoint attributes on demand. Each poll is bounded by the source's poll
+// timeout. Returns the result of every endpoint, in the order of endpoints, and
+// the failures joined, each annotated with its endpoint. Durations are measured
+// with the wall clock, unless set with WithClock.
+func CollectAll(ctx context.Context, src fwkdl.PollingDataSource, extractors []fwkdl.Extractor,
+	endpoints []fwkdl.Endpoint, opts ...ClockOption) ([]CollectResult, error) {
+	return StartCollectAll(ctx, src, extractors, endpoints, opts...).Wait()
+}
+
+// CollectBatch is a CollectAll in progress, started with StartCollectAll.
+type CollectBatch struct {
+	endpoints []fwkdl.Endpoint
+	cancels   []context.CancelCauseFunc // by index in endpoints
+	results   []CollectResult
+	wg        sync.WaitGroup
+}
+
+// StartCollectAll starts collecting from all endpoints, as CollectAll does, and
+// returns without waiting. Each endpoint is collected with its own context, so
+// that it can be cancelled, e.g., by a per-endpoint circuit breaker, without
+// affecting the others. Cancelling ctx cancels the whole batch.
+func StartCollectAll(ctx context.Context, src fwkdl.PollingDataSource, extractors []fwkdl.Extractor,
+	endpoints []fwkdl.Endpoint, opts ...ClockOption) *CollectBatch {
+	clock := clockOf(opts)
+	b := &CollectBatch{
+		endpoints: endpoints,
+		cancels:   make([]context.CancelCauseFunc, len(endpoints)),
+		results:   make([]CollectResult, len(endpoints)),
+	}
+	for i, ep := range endpoints {
+		epCtx, cancel := context.WithCancelCause(ctx)
+		b.cancels[i] = cancel
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			defer cancel(nil)
+			start := clock.Now()
+			err := collect(epCtx, src, extractors, ep)
+			if err != nil && errors.Is(context.Cause(epCtx), ErrCollectCancelled) {
+				err = fmt.Errorf("%w: %w", ErrCollectCancelled, err)
+			}
+			b.results[i] = CollectResult{Endpoint: ep, Err: err, Duration: clock.Now().Sub(start)}
+		}()
+	}
+	return b
+}
+
+// Cancel aborts the collection from the endpoint, if still running: its poll and
+// extractors see their context cancelled, and a resulting failure wraps
+// ErrCollectCancelled. The other endpoints of the batch are not affected, even if
+// they share the endpoint's key. Returns false if the endpoint is not one of the
+// endpoints the batch was started with.
+func (b *CollectBatch) Cancel(ep fwkdl.Endpoint) bool {
+	found := false
+	for i, other := range b.endpoints {
+		if other == ep {
+			b.cancels[i](ErrCollectCancelled)
+			found = true
+		}
+	}
+	return found
+}
+
+// Wait waits for all endpoints to be collected and returns their results, as
+// CollectAll does.
+func (b *CollectBatch) Wait() ([]CollectResult, error) {
+	b.wg.Wait()
+
+	var errs []error
+	for _, result := range b.results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("endpoint %s: %w", fwkdl.EndpointKey(result.Endpoint), result.Err))
+		}
+	}
+	return b.results, errors.Join(errs...)
+}
+
+// collect polls a single endpoint and runs the extractors on the data.
+func collect(ctx context.Context, src fwkdl.PollingDataSource, extractors []fwkdl.Extractor, ep fwkdl.Endpoint) error {
+	ctx, cancel := context.WithTimeout(ctx, pollTimeout(src, ep))
+	defer cancel()
+
+	data, err := src.Poll(ctx, ep)
+	if errors.Is(err, fwkdl.ErrSkip) || (err == nil && data == nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, ext := range extractors {
+		if err := ext.Extract(ctx, data, ep); err != nil && !errors.Is(err, fwkdl.ErrSkip) {
+			errs = append(errs, fmt.Errorf("extractor %s: %w", ext.TypedName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
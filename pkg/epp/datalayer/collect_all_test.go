@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testclock "k8s.io/utils/clock/testing"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+	datasourcemocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/mocks"
+)
+
+// endpointErrSource fails polling the endpoints whose name starts with "bad",
+// and returns empty metrics for the others.
+type endpointErrSource struct {
+	datasourcemocks.MetricsDataSource
+}
+
+func (s *endpointErrSource) Poll(ctx context.Context, ep fwkdl.Endpoint) (any, error) {
+	if strings.HasPrefix(ep.GetMetadata().NamespacedName.Name, "bad") {
+		return nil, errors.New("connection refused")
+	}
+	if _, err := s.MetricsDataSource.Poll(ctx, ep); err != nil {
+		return nil, err
+	}
+	return &fwkdl.Metrics{}, nil
+}
+
+func TestCollectAll(t *testing.T) {
+	endpoints := []fwkdl.Endpoint{
+		newLabeledEndpoint("good-1", nil),
+		newLabeledEndpoint("bad-1", nil),
+		newLabeledEndpoint("good-2", nil),
+		newLabeledEndpoint("bad-2", nil),
+	}
+	ext := extractormocks.NewPollingExtractor("ext")
+
+	results, err := CollectAll(context.Background(), &endpointErrSource{}, []fwkdl.Extractor{ext}, endpoints)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "endpoint default/bad-1")
+	assert.Contains(t, err.Error(), "endpoint default/bad-2")
+	assert.NotContains(t, err.Error(), "good")
+
+	require.Len(t, results, len(endpoints))
+	for i, result := range results {
+		assert.Same(t, endpoints[i], result.Endpoint, "results should be in the order of endpoints")
+		if strings.HasPrefix(result.Endpoint.GetMetadata().NamespacedName.Name, "bad") {
+			assert.ErrorContains(t, result.Err, "connection refused")
+		} else {
+			assert.NoError(t, result.Err)
+		}
+		assert.GreaterOrEqual(t, result.Duration, time.Duration(0))
+	}
+	assert.EqualValues(t, 2, ext.CallCount(), "extractors should only run on collected data")
+}
+
+func TestCollectAllSucceeds(t *testing.T) {
+	results, err := CollectAll(context.Background(), &endpointErrSource{}, nil,
+		[]fwkdl.Endpoint{newLabeledEndpoint("good", nil)})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.NoError(t, results[0].Err)
+}
+
+// steppingSource advances its clock by step on every poll.
+type steppingSource struct {
+	endpointErrSource
+	clock *testclock.FakeClock
+	step  time.Duration
+}
+
+func (s *steppingSource) Poll(ctx context.Context, ep fwkdl.Endpoint) (any, error) {
+	s.clock.Step(s.step)
+	return s.endpointErrSource.Poll(ctx, ep)
+}
+
+func TestCollectAllClock(t *testing.T) {
+	clk := testclock.NewFakeClock(time.Now())
+	src := &steppingSource{clock: clk, step: 3 * time.Second}
+
+	results, err := CollectAll(context.Background(), src, nil,
+		[]fwkdl.Endpoint{newLabeledEndpoint("good", nil)}, WithClock(clk))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, 3*time.Second, results[0].Duration, "durations should be measured with the given clock")
+}
+
+// gatedSource blocks polls of the endpoints named "slow" until their context is
+// done.
+type gatedSource struct {
+	endpointErrSource
+	polling chan struct{}
+}
+
+func (s *gatedSource) Poll(ctx context.Context, ep fwkdl.Endpoint) (any, error) {
+	if ep.GetMetadata().NamespacedName.Name == "slow" {
+		s.polling <- struct{}{}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return s.endpointErrSource.Poll(ctx, ep)
+}
+
+func TestCollectBatchCancel(t *testing.T) {
+	slow := newLabeledEndpoint("slow", nil)
+	endpoints := []fwkdl.Endpoint{newLabeledEndpoint("good-1", nil), slow, newLabeledEndpoint("good-2", nil)}
+	src := &gatedSource{polling: make(chan struct{}, 1)}
+	ext := extractormocks.NewPollingExtractor("ext")
+
+	batch := StartCollectAll(context.Background(), src, []fwkdl.Extractor{ext}, endpoints)
+	<-src.polling
+	assert.True(t, batch.Cancel(slow))
+	assert.False(t, batch.Cancel(newLabeledEndpoint("unknown", nil)))
+
+	results, err := batch.Wait()
+	require.ErrorIs(t, err, ErrCollectCancelled)
+	assert.Contains(t, err.Error(), "endpoint default/slow")
+	require.Len(t, results, len(endpoints))
+	assert.NoError(t, results[0].Err)
+	assert.ErrorIs(t, results[1].Err, ErrCollectCancelled)
+	assert.ErrorIs(t, results[1].Err, context.Canceled)
+	assert.NoError(t, results[2].Err)
+	assert.EqualValues(t, 2, ext.CallCount(), "the other endpoints should complete")
+}
+
+// blockingSource blocks every poll until its context is done.
+type blockingSource struct {
+	endpointErrSource
+	polling chan struct{}
+}
+
+func (s *blockingSource) Poll(ctx context.Context, _ fwkdl.Endpoint) (any, error) {
+	s.polling <- struct{}{}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestCollectBatchCancelWithoutMetadata(t *testing.T) {
+	// neither endpoint has metadata to key it by.
+	endpoints := []fwkdl.Endpoint{fwkdl.NewEndpoint(nil, nil), fwkdl.NewEndpoint(nil, nil)}
+	src := &blockingSource{polling: make(chan struct{}, len(endpoints))}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	batch := StartCollectAll(ctx, src, nil, endpoints)
+	for range endpoints {
+		<-src.polling
+	}
+	assert.True(t, batch.Cancel(endpoints[0]))
+	assert.False(t, batch.Cancel(fwkdl.NewEndpoint(nil, nil)))
+	cancel()
+
+	results, _ := batch.Wait()
+	require.Len(t, results, len(endpoints))
+	assert.ErrorIs(t, results[0].Err, ErrCollectCancelled)
+	assert.ErrorIs(t, results[1].Err, context.Canceled)
+	assert.NotErrorIs(t, results[1].Err, ErrCollectCancelled, "only the cancelled endpoint should be affected")
+}
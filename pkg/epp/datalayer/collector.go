@@ -40,6 +40,16 @@ const (
 	defaultCollectionTimeout = time.Second
 )
 
+// pollTimeout returns the deadline for polling ep from src.
+func pollTimeout(src fwkdl.PollingDataSource, ep fwkdl.Endpoint) time.Duration {
+	if ts, ok := src.(fwkdl.PollTimeoutSource); ok {
+		if timeout := ts.PollTimeout(ep); timeout > 0 {
+			return timeout
+		}
+	}
+	return defaultCollectionTimeout
+}
+
 // Ticker implements a time source for periodic invocation.
 // The Ticker is passed in as parameter a Collector to allow control over time
 // progress in tests, ensuring tests are deterministic and fast.
@@ -116,7 +126,7 @@ func (c *Collector) startCollection(ctx context.Context, ticker Ticker, ep fwkdl
 	started := false
 
 	c.startOnce.Do(func() {
-		logger := log.FromContext(ctx).WithValues("endpoint", ep.GetMetadata().GetIPAddress())
+		logger := log.FromContext(ctx).WithValues("endpoint", ep.GetMetadata().GetIPAddress(), "endpointKey", fwkdl.EndpointKey(ep))
 		c.ctx, c.cancel = context.WithCancel(ctx)
 		started = true
 		ready = make(chan struct{})
@@ -141,11 +151,15 @@ func (c *Collector) startCollection(ctx context.Context, ticker Ticker, ep fwkdl
 						tn := src.TypedName()
 						key := tn.String()
 
-						ctx, cancel := context.WithTimeout(c.ctx, defaultCollectionTimeout)
+						ctx, cancel := context.WithTimeout(c.ctx, pollTimeout(src, endpoint))
 						data, err := src.Poll(ctx, endpoint)
 						cancel()
+						if errors.Is(err, fwkdl.ErrSkip) {
+							continue // skipped polls do not change the error state
+						}
 
-						logErrorTransition(logger, c.lastPollErrors, key, "poll", "source", err)
+						logErrorTransition(logger, c.lastPollErrors, key, "poll", "source", err,
+							"errorKind", fwkdl.ClassifyCollectError(err).String())
 						if err != nil {
 							continue
 						}
@@ -154,6 +168,9 @@ func (c *Collector) startCollection(ctx context.Context, ticker Ticker, ep fwkdl
 							for _, ext := range srcExtractors {
 								extKey := ext.TypedName().String()
 								extErr := ext.Extract(ctx, data, endpoint)
+								if errors.Is(extErr, fwkdl.ErrSkip) {
+									extErr = nil // deliberate skips are not failures
+								}
 								logErrorTransition(logger, c.lastExtractErrors, extKey, "extract", "extractor", extErr)
 							}
 						}
@@ -204,11 +221,12 @@ func (c *Collector) Stop() error {
 
 // logErrorTransition logs only when the error state transitions (nil→non-nil or non-nil→nil).
 // errs is updated in place. verb is the operation name ("poll"/"extract"); fieldName is the log key.
-func logErrorTransition(logger logr.Logger, errs map[string]error, key, verb, fieldName string, err error) {
+// errKeysAndValues are only added to the failure log line.
+func logErrorTransition(logger logr.Logger, errs map[string]error, key, verb, fieldName string, err error, errKeysAndValues ...any) {
 	prev, seen := errs[key]
 	if (err != nil) != (seen && prev != nil) {
 		if err != nil {
-			logger.Error(err, verb+" failed", fieldName, key)
+			logger.Error(err, verb+" failed", append([]any{fieldName, key}, errKeysAndValues...)...)
 		} else {
 			logger.V(logging.DEFAULT).Info(verb+" recovered", fieldName, key)
 		}
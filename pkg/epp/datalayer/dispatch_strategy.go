@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+// ErrExtractorAbandoned is reported by CancellableDispatch for the extractors
+// that had not finished when the context of the dispatch was done.
+var ErrExtractorAbandoned = errors.New("extractor abandoned")
+
+// errExtractorDisabled is reported for the extractors disabled at runtime (see
+// fwkdl.ExtractorToggleSource).
+var errExtractorDisabled = fmt.Errorf("extractor disabled: %w", fwkdl.ErrSkip)
+
+// dispatchStrategies maps the names accepted by NewDispatchStrategy to builders
+// of the strategies.
+var dispatchStrategies = map[string]func() fwkdl.DispatchStrategy{
+	"sequential":  func() fwkdl.DispatchStrategy { return fwkdl.SequentialDispatch },
+	"cancellable": func() fwkdl.DispatchStrategy { return CancellableDispatch(fwkdl.SequentialDispatch) },
+}
+
+// NewDispatchStrategy returns the dispatch strategy of the given name, e.g., as
+// selected in a configuration: "sequential", the default of the core, or
+// "cancellable", the sequential dispatch wrapped by CancellableDispatch.
+func NewDispatchStrategy(name string) (fwkdl.DispatchStrategy, error) {
+	build, ok := dispatchStrategies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown dispatch strategy %q, valid strategies: %s", name,
+			strings.Join(slices.Sorted(maps.Keys(dispatchStrategies)), ", "))
+	}
+	return build(), nil
+}
+
+// CancellableDispatch wraps a DispatchStrategy so that the dispatch returns as
+// soon as its context is done, e.g., on shutdown, instead of once all extractors
+// returned. Extractors still running, or not started yet, are reported with an
+// error wrapping ErrExtractorAbandoned and the cause of the cancellation. Running
+// extractors are detached: they see their context cancelled and their results
+// are discarded. Extractors not yet started are not invoked, whether strategy
+// honors the context or not.
+//
+// The framework core keeps the event object locked, and detached extractors
+// counted in flight, until they return, so that they never overlap the next
+// event of the object. Only that object is held: the events of other objects
+// are processed meanwhile. Extractors must still honor the cancellation of their
+// context, or the later events of the object wait for them.
+func CancellableDispatch(strategy fwkdl.DispatchStrategy) fwkdl.DispatchStrategy {
+	return &cancellableDispatch{strategy: strategy}
+}
+
+type cancellableDispatch struct {
+	strategy fwkdl.DispatchStrategy
+}
+
+func (c *cancellableDispatch) Dispatch(ctx context.Context, event fwkdl.NotificationEvent,
+	extractors []fwkdl.NotificationExtractor) []error {
+	results := &dispatchResults{errs: make([]error, len(extractors)), finished: make([]bool, len(extractors))}
+	tracked := make([]fwkdl.NotificationExtractor, len(extractors))
+	for i, ext := range extractors {
+		tracked[i] = &trackedExtractor{NotificationExtractor: ext, results: results, pos: i}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.strategy.Dispatch(ctx, event, tracked) // per extractor errors are tracked
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return results.seal(context.Cause(ctx))
+}
+
+// dispatchResults collects the errors of the extractors of a cancellable
+// dispatch until it is sealed.
+type dispatchResults struct {
+	mu       sync.Mutex
+	sealed   bool
+	errs     []error
+	finished []bool
+}
+
+func (r *dispatchResults) set(pos int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.sealed {
+		r.errs[pos], r.finished[pos] = err, true
+	}
+}
+
+// seal stops collecting and returns the errors, reporting the unfinished
+// extractors as abandoned for cause.
+func (r *dispatchResults) seal(cause error) []error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sealed = true
+	errs := slices.Clone(r.errs)
+	for i, finished := range r.finished {
+		if !finished {
+			errs[i] = fmt.Errorf("%w: %w", ErrExtractorAbandoned, cause)
+		}
+	}
+	return errs
+}
+
+func (r *dispatchResults) isSealed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.sealed
+}
+
+// trackedExtractor records the result of an extractor of a cancellable dispatch.
+type trackedExtractor struct {
+	fwkdl.NotificationExtractor
+	results *dispatchResults
+	pos     int
+}
+
+func (te *trackedExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	if te.results.isSealed() {
+		return fmt.Errorf("%w: %w", ErrExtractorAbandoned, context.Cause(ctx))
+	}
+	err := te.NotificationExtractor.ExtractNotification(ctx, event)
+	te.results.set(te.pos, err)
+	return err
+}
+
+// DependsOn implements fwkdl.DependentExtractor.
+func (te *trackedExtractor) DependsOn() []string {
+	return dependsOnOf(te.NotificationExtractor)
+}
+
+// extractorOutcomes collects the audit outcomes of the extractors of an event
+// until the dispatch returns. Outcomes of extractors detached by the strategy
+// (see CancellableDispatch) arriving later are discarded.
+type extractorOutcomes struct {
+	mu       sync.Mutex
+	sealed   bool
+	outcomes []fwkdl.ExtractorOutcome // zero until invoked
+}
+
+func (o *extractorOutcomes) set(pos int, outcome fwkdl.ExtractorOutcome) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !o.sealed {
+		o.outcomes[pos] = outcome
+	}
+}
+
+// seal stops collecting and returns the outcomes.
+func (o *extractorOutcomes) seal() []fwkdl.ExtractorOutcome {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.sealed = true
+	return o.outcomes
+}
+
+// extractorRuns counts the extractors of a dispatch still running, so that the
+// lock of the event object is released once the last of them returns, including
+// those that a strategy detached (see CancellableDispatch).
+type extractorRuns struct {
+	mu       sync.Mutex
+	running  int
+	release  func() // set once the dispatch returned
+	released bool
+}
+
+// start reports whether the extractor may run, i.e., the lock is still held.
+func (r *extractorRuns) start() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.released {
+		return false
+	}
+	r.running++
+	return true
+}
+
+func (r *extractorRuns) done() {
+	r.mu.Lock()
+	r.running--
+	release := r.releasable()
+	r.mu.Unlock()
+	release()
+}
+
+// releaseWhenDone calls release once no extractor is running, possibly now.
+func (r *extractorRuns) releaseWhenDone(release func()) {
+	r.mu.Lock()
+	r.release = release
+	release = r.releasable()
+	r.mu.Unlock()
+	release()
+}
+
+// releasable returns the release function if it is due, or a no-op. The caller
+// must hold r.mu.
+func (r *extractorRuns) releasable() func() {
+	if r.release == nil || r.running > 0 || r.released {
+		return func() {}
+	}
+	r.released = true
+	return r.release
+}
+
+// dispatchesInFlight counts the events being processed, from their dispatch until
+// their last extractor returns, for sources draining their backlog to wait for.
+type dispatchesInFlight struct {
+	mu   sync.Mutex
+	n    int
+	idle chan struct{} // closed once n drops to zero
+}
+
+func (d *dispatchesInFlight) add() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.n == 0 {
+		d.idle = make(chan struct{})
+	}
+	d.n++
+}
+
+func (d *dispatchesInFlight) done() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.n--; d.n == 0 {
+		close(d.idle)
+	}
+}
+
+// await waits until no event is being processed or ctx is done, and returns the
+// number of events still being processed.
+func (d *dispatchesInFlight) await(ctx context.Context) int {
+	d.mu.Lock()
+	if d.n == 0 {
+		d.mu.Unlock()
+		return 0
+	}
+	idle := d.idle
+	d.mu.Unlock()
+	select {
+	case <-idle:
+		return 0
+	case <-ctx.Done():
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		return d.n
+	}
+}
+
+// instrumentedExtractor wraps an extractor handed to a DispatchStrategy with the
+// bookkeeping of the core: runtime disabling, heartbeat, deadline, tracing,
+// latency, in-flight count and the audit outcome. Strategies may invoke the
+// wrappers concurrently.
+type instrumentedExtractor struct {
+	fwkdl.NotificationExtractor
+	rn       *notificationReconciler
+	log      logr.Logger
+	index    int // of the extractor in rn.extractors
+	pos      int // of the extractor in the dispatch order
+	outcomes *extractorOutcomes
+	runs     *extractorRuns
+}
+
+func (ie *instrumentedExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	ext, rn := ie.NotificationExtractor, ie.rn
+	if !ie.runs.start() { // the dispatch returned, and the next event may be running
+		return fmt.Errorf("%w: %w", ErrExtractorAbandoned, context.Cause(ctx))
+	}
+	defer ie.runs.done()
+	if rn.toggles != nil && rn.toggles.SkipExtractor(ext.TypedName().Name) {
+		ie.outcomes.set(ie.pos, fwkdl.ExtractorOutcome{Extractor: ext.TypedName(), Err: errExtractorDisabled})
+		return errExtractorDisabled
+	}
+	before := rn.clock.Now()
+	stopHeartbeat := rn.startHeartbeat(ie.log, ext, before)
+	rn.recordInFlight(1)
+	err := rn.extractWithDeadline(ctx, ie.log, ext, event)
+	rn.recordInFlight(-1)
+	if rn.observeExtraction != nil {
+		rn.observeExtraction(ie.index, event, err)
+	}
+	stopHeartbeat()
+	elapsed := rn.clock.Now().Sub(before)
+	rn.latency.observe(ie.index, elapsed)
+	metrics.RecordPluginProcessingLatency(extractNotificationExtensionPoint, ext.TypedName().Type, ext.TypedName().Name, elapsed)
+	ie.outcomes.set(ie.pos, fwkdl.ExtractorOutcome{Extractor: ext.TypedName(), Err: err, Duration: elapsed})
+	return err
+}
+
+// DependsOn implements fwkdl.DependentExtractor.
+func (ie *instrumentedExtractor) DependsOn() []string {
+	return dependsOnOf(ie.NotificationExtractor)
+}
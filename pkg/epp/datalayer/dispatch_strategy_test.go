@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
+)
+
+func TestCancellableDispatch(t *testing.T) {
+	fast := extractormocks.NewNotificationExtractor("fast")
+	stuck := &gatedExtractor{
+		NotificationExtractor: extractormocks.NewNotificationExtractor("stuck"),
+		started:               make(chan struct{}),
+		release:               make(chan struct{}),
+	}
+	later := extractormocks.NewNotificationExtractor("later")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	result := make(chan []error)
+	go func() {
+		result <- CancellableDispatch(fwkdl.SequentialDispatch).Dispatch(ctx,
+			fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}},
+			[]fwkdl.NotificationExtractor{fast, stuck, later})
+	}()
+	<-stuck.started
+	cancel()
+	errs := <-result
+
+	require.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	for _, err := range errs[1:] {
+		assert.ErrorIs(t, err, ErrExtractorAbandoned)
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+
+	close(stuck.release) // the detached extractor completes in the background
+	assert.Eventually(t, func() bool {
+		return len(stuck.NotificationExtractor.(*extractormocks.NotificationExtractor).GetEvents()) == 1
+	}, time.Second, time.Millisecond)
+	assert.Never(t, func() bool { return len(later.GetEvents()) > 0 }, 50*time.Millisecond, time.Millisecond,
+		"extractors not started before the cancellation should not run")
+}
+
+func TestCancellableDispatchCompletes(t *testing.T) {
+	exts := newNotificationExtractors(2)
+	errs := CancellableDispatch(fwkdl.SequentialDispatch).Dispatch(context.Background(),
+		fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}},
+		asNotificationExtractors(exts))
+	assert.Equal(t, []error{nil, nil}, errs)
+	for _, ext := range exts {
+		assert.Len(t, ext.GetEvents(), 1)
+	}
+}
+
+func TestNotificationReconcilerReportsAbandonedExtractors(t *testing.T) {
+	var errorLines []string
+	log := funcr.New(func(_, args string) {
+		if strings.Contains(args, `"error"=`) {
+			errorLines = append(errorLines, args)
+		}
+	}, funcr.Options{})
+
+	stuck := &gatedExtractor{
+		NotificationExtractor: extractormocks.NewNotificationExtractor("stuck"),
+		started:               make(chan struct{}),
+		release:               make(chan struct{}),
+	}
+	t.Cleanup(func() { close(stuck.release) })
+	done := extractormocks.NewNotificationExtractor("done")
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithDispatchStrategy(CancellableDispatch(fwkdl.SequentialDispatch)))
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{done, stuck}, log)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stuck.started
+		cancel()
+	}()
+	_, err := rn.dispatch(ctx, log, &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}})
+	require.NoError(t, err)
+
+	require.Len(t, errorLines, 1)
+	assert.Contains(t, errorLines[0], `"failed"=1`)
+	assert.Contains(t, errorLines[0], "extractor "+stuck.TypedName().String()+": extractor abandoned")
+	assert.NotContains(t, errorLines[0], done.TypedName().String())
+}
+
+// lingeringExtractor blocks its first invocation, ignoring its context, until release
+// is closed, and records whether invocations overlapped.
+type lingeringExtractor struct {
+	*extractormocks.NotificationExtractor
+	started    chan struct{}
+	release    chan struct{}
+	calls      atomic.Int32
+	running    atomic.Int32
+	overlapped atomic.Bool
+}
+
+func (s *lingeringExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	if s.running.Add(1) > 1 {
+		s.overlapped.Store(true)
+	}
+	defer s.running.Add(-1)
+	if s.calls.Add(1) == 1 {
+		close(s.started)
+		<-s.release
+	}
+	return s.NotificationExtractor.ExtractNotification(ctx, event)
+}
+
+func TestNotificationReconcilerAbandonedExtractorHoldsObject(t *testing.T) {
+	slow := &lingeringExtractor{
+		NotificationExtractor: extractormocks.NewNotificationExtractor("slow"),
+		started:               make(chan struct{}),
+		release:               make(chan struct{}),
+	}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithDispatchStrategy(CancellableDispatch(fwkdl.SequentialDispatch)))
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{slow}, logr.Discard())
+	var inFlight atomic.Int64
+	rn.recordInFlight = func(delta int) { inFlight.Add(int64(delta)) }
+	newEvent := func() *fwkdl.NotificationEvent {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace("default")
+		obj.SetName("pod")
+		return &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-slow.started
+		cancel()
+	}()
+	_, err := rn.dispatch(ctx, logr.Discard(), newEvent())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), inFlight.Load(), "the abandoned extractor should still be counted in flight")
+
+	next := make(chan struct{})
+	go func() {
+		defer close(next)
+		_, _ = rn.dispatch(context.Background(), logr.Discard(), newEvent())
+	}()
+	assert.Never(t, func() bool { return slow.calls.Load() > 1 }, 50*time.Millisecond, time.Millisecond,
+		"the next event of the object should wait for the abandoned extractor")
+
+	close(slow.release)
+	<-next
+	assert.Equal(t, int32(2), slow.calls.Load())
+	assert.False(t, slow.overlapped.Load(), "events of the same object should not overlap")
+	assert.Zero(t, inFlight.Load())
+}
+
+func TestNotificationReconcilerAbandonedExtractorHoldsOnlyItsObject(t *testing.T) {
+	slow := &lingeringExtractor{
+		NotificationExtractor: extractormocks.NewNotificationExtractor("slow"),
+		started:               make(chan struct{}),
+		release:               make(chan struct{}),
+	}
+	defer close(slow.release)
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithDispatchStrategy(CancellableDispatch(fwkdl.SequentialDispatch)))
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{slow}, logr.Discard())
+	newEvent := func(name string) *fwkdl.NotificationEvent {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace("default")
+		obj.SetName(name)
+		return &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-slow.started
+		cancel()
+	}()
+	_, err := rn.dispatch(ctx, logr.Discard(), newEvent("abandoned"))
+	require.NoError(t, err)
+
+	// whatever the keys of the objects, only the abandoned one is held.
+	for i := range 100 {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = rn.dispatch(context.Background(), logr.Discard(), newEvent(fmt.Sprintf("pod-%d", i)))
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatalf("event of pod-%d waited for the abandoned extractor of another object", i)
+		}
+	}
+	assert.Equal(t, int32(101), slow.calls.Load())
+}
+
+func TestNotificationReconcilerDrainAwaitsAbandonedExtractors(t *testing.T) {
+	slow := &lingeringExtractor{
+		NotificationExtractor: extractormocks.NewNotificationExtractor("slow"),
+		started:               make(chan struct{}),
+		release:               make(chan struct{}),
+	}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithDispatchStrategy(CancellableDispatch(fwkdl.SequentialDispatch)))
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{slow}, logr.Discard())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-slow.started
+		cancel()
+	}()
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("default")
+	obj.SetName("pod")
+	_, err := rn.dispatch(ctx, logr.Discard(), &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+	require.NoError(t, err)
+
+	timeout, cancelTimeout := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelTimeout()
+	undrained, err := src.Drain(timeout)
+	require.NoError(t, err)
+	assert.Equal(t, 1, undrained, "the event of the abandoned extractor should be reported undrained")
+
+	close(slow.release)
+	undrained, err = src.Drain(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, undrained, "drain should wait for the abandoned extractor to return")
+}
+
+func TestNewDispatchStrategy(t *testing.T) {
+	ext := extractormocks.NewNotificationExtractor("ext")
+	event := fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}}
+	for _, name := range []string{"sequential", "cancellable"} {
+		t.Run(name, func(t *testing.T) {
+			strategy, err := NewDispatchStrategy(name)
+			require.NoError(t, err)
+			assert.Equal(t, []error{nil}, strategy.Dispatch(context.Background(), event, []fwkdl.NotificationExtractor{ext}))
+		})
+	}
+
+	sequential, err := NewDispatchStrategy("sequential")
+	require.NoError(t, err)
+	assert.Equal(t, fwkdl.SequentialDispatch, sequential)
+	cancellable, err := NewDispatchStrategy("cancellable")
+	require.NoError(t, err)
+	assert.IsType(t, &cancellableDispatch{}, cancellable)
+
+	_, err = NewDispatchStrategy("per-key")
+	assert.EqualError(t, err, `unknown dispatch strategy "per-key", valid strategies: cancellable, sequential`)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// DryRunExtractor decorates a NotificationExtractor to run it in dry-run mode,
+// e.g., to verify a new extractor against live events before enabling it: the
+// extractor sees fwkdl.IsDryRun on its context and is expected to skip its side
+// effects. Its errors are still returned, so failures are reported as usual.
+type DryRunExtractor struct {
+	fwkdl.NotificationExtractor
+}
+
+var _ fwkdl.NotificationExtractor = (*DryRunExtractor)(nil)
+
+// NewDryRunExtractor wraps ext to run it in dry-run mode.
+func NewDryRunExtractor(ext fwkdl.NotificationExtractor) *DryRunExtractor {
+	return &DryRunExtractor{NotificationExtractor: ext}
+}
+
+// DependsOn implements fwkdl.DependentExtractor.
+func (d *DryRunExtractor) DependsOn() []string {
+	return dependsOnOf(d.NotificationExtractor)
+}
+
+// ExtractNotification invokes the wrapped extractor with a dry-run context and
+// logs the outcome.
+func (d *DryRunExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	err := d.NotificationExtractor.ExtractNotification(fwkdl.WithDryRun(ctx), event)
+	log.FromContext(ctx).V(logging.DEBUG).Info("dry-run extractor processed event", "extractor", d.TypedName(),
+		"eventType", event.Type, "resource", types.NamespacedName{Namespace: event.Namespace(), Name: event.Name()},
+		"error", err)
+	return err
+}
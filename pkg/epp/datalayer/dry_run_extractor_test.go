@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+)
+
+// dryRunRecordingExtractor records whether each invocation was a dry-run.
+type dryRunRecordingExtractor struct {
+	fwkdl.NotificationExtractor
+	dryRuns []bool
+}
+
+func (e *dryRunRecordingExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	e.dryRuns = append(e.dryRuns, fwkdl.IsDryRun(ctx))
+	return e.NotificationExtractor.ExtractNotification(ctx, event)
+}
+
+func TestDryRunExtractor(t *testing.T) {
+	failure := errors.New("extraction failed")
+	inner := &dryRunRecordingExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("pods").WithExtractError(failure)}
+	ext := NewDryRunExtractor(inner)
+	assert.Equal(t, inner.TypedName(), ext.TypedName())
+
+	ctx := context.Background()
+	event := fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}}
+	assert.ErrorIs(t, ext.ExtractNotification(ctx, event), failure, "errors should still be reported in dry-run mode")
+	assert.False(t, fwkdl.IsDryRun(ctx), "the caller's context should not be marked")
+	_ = inner.ExtractNotification(ctx, event)
+	assert.Equal(t, []bool{true, false}, inner.dryRuns, "only the decorated invocation should be a dry-run")
+}
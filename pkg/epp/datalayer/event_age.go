@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// lastChangeTime returns the most recent change timestamp recorded on obj: the
+// latest managedFields entry, status condition transition or, failing those, the
+// creation timestamp. Timestamps have a one second resolution, so ages derived
+// from them are approximate. Returns false if obj carries no timestamp.
+func lastChangeTime(obj *unstructured.Unstructured) (time.Time, bool) {
+	if obj == nil {
+		return time.Time{}, false
+	}
+
+	var latest time.Time
+	for _, mf := range obj.GetManagedFields() {
+		if mf.Time != nil && mf.Time.After(latest) {
+			latest = mf.Time.Time
+		}
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		raw, ok := cond["lastTransitionTime"].(string)
+		if !ok {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, raw); err == nil && t.After(latest) {
+			latest = t
+		}
+	}
+
+	if latest.IsZero() {
+		latest = obj.GetCreationTimestamp().Time
+	}
+	return latest, !latest.IsZero()
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	testclock "k8s.io/utils/clock/testing"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
+)
+
+var (
+	testCreated = time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdated = testCreated.Add(time.Minute)
+)
+
+func newTimestampedObject(managedAt, transitionAt time.Time) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetName("pod")
+	obj.SetCreationTimestamp(metav1.NewTime(testCreated))
+	if !managedAt.IsZero() {
+		obj.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubelet", Time: &metav1.Time{Time: managedAt}}})
+	}
+	if !transitionAt.IsZero() {
+		_ = unstructured.SetNestedSlice(obj.Object, []any{
+			map[string]any{"type": "Ready", "lastTransitionTime": transitionAt.Format(time.RFC3339)},
+		}, "status", "conditions")
+	}
+	return obj
+}
+
+func TestLastChangeTime(t *testing.T) {
+	tests := []struct {
+		name   string
+		obj    *unstructured.Unstructured
+		want   time.Time
+		wantOK bool
+	}{
+		{name: "creation only", obj: newTimestampedObject(time.Time{}, time.Time{}), want: testCreated, wantOK: true},
+		{name: "managed fields", obj: newTimestampedObject(testUpdated, time.Time{}), want: testUpdated, wantOK: true},
+		{name: "latest condition transition", obj: newTimestampedObject(testUpdated, testUpdated.Add(time.Second)),
+			want: testUpdated.Add(time.Second), wantOK: true},
+		{name: "no timestamps", obj: &unstructured.Unstructured{Object: map[string]any{}}, wantOK: false},
+		{name: "nil object", obj: nil, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := lastChangeTime(tt.obj)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.True(t, tt.want.Equal(got), "want %v, got %v", tt.want, got)
+		})
+	}
+}
+
+func TestNotificationReconcilerEventAge(t *testing.T) {
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+	rn := newNotificationReconciler(nil, src, nil, logr.Discard())
+	clk := testclock.NewFakeClock(testUpdated)
+	rn.clock = clk
+	clk.Step(3 * time.Second)
+
+	var ages []time.Duration
+	rn.recordAge = func(age time.Duration) { ages = append(ages, age) }
+
+	events := []fwkdl.NotificationEvent{
+		{Type: fwkdl.EventAddOrUpdate, Object: newTimestampedObject(testUpdated, time.Time{})},
+		{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{Object: map[string]any{}}}, // no timestamp
+		{Type: fwkdl.EventDelete, Object: newTimestampedObject(testUpdated, time.Time{})},            // not measured
+	}
+	for _, event := range events {
+		_, err := rn.dispatch(context.Background(), logr.Discard(), &event)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []time.Duration{3 * time.Second}, ages)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// enqueueTracker records the delivery metadata of queued reconcile requests until
+// the reconciler takes them. The queue coalesces requests for the same object, so
+// only the metadata of the first pending one is kept.
+type enqueueTracker struct {
+	clock   Clock
+	mu      sync.Mutex
+	pending map[types.NamespacedName]fwkdl.EventMeta
+}
+
+func newEnqueueTracker(clock Clock) *enqueueTracker {
+	return &enqueueTracker{clock: clock, pending: make(map[types.NamespacedName]fwkdl.EventMeta)}
+}
+
+func (t *enqueueTracker) record(obj client.Object, sync bool, queueLength int) {
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.pending[key]; !ok {
+		t.pending[key] = fwkdl.EventMeta{Sync: sync, EnqueueTime: t.clock.Now(), QueueLength: queueLength}
+	}
+}
+
+// take returns and forgets the metadata recorded for the object, or the zero
+// value if there is none.
+func (t *enqueueTracker) take(key types.NamespacedName) fwkdl.EventMeta {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	meta := t.pending[key]
+	delete(t.pending, key)
+	return meta
+}
+
+// metaRecordingHandler enqueues a request for the event object, as
+// handler.EnqueueRequestForObject does, after recording its delivery metadata.
+// Recording first ensures the metadata is available once a worker picks the
+// request up.
+type metaRecordingHandler struct {
+	handler.EnqueueRequestForObject
+	tracker *enqueueTracker
+}
+
+var _ handler.EventHandler = (*metaRecordingHandler)(nil)
+
+func (h *metaRecordingHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if evt.Object != nil {
+		h.tracker.record(evt.Object, evt.IsInInitialList, q.Len())
+	}
+	h.EnqueueRequestForObject.Create(ctx, evt, q)
+}
+
+func (h *metaRecordingHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if evt.ObjectNew != nil {
+		h.tracker.record(evt.ObjectNew, false, q.Len())
+	}
+	h.EnqueueRequestForObject.Update(ctx, evt, q)
+}
+
+func (h *metaRecordingHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if evt.Object != nil {
+		h.tracker.record(evt.Object, false, q.Len())
+	}
+	h.EnqueueRequestForObject.Delete(ctx, evt, q)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	testclock "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
+)
+
+func TestNotificationReconcilerEventMeta(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}
+	other := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+	c := fake.NewClientBuilder().WithObjects(pod, other).Build()
+	clk := testclock.NewFakeClock(time.Now())
+	ext := newNotificationExtractors(1)[0]
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+
+	rn := newNotificationReconciler(c, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+	rn.enqueued = newEnqueueTracker(clk)
+	h := &metaRecordingHandler{tracker: rn.enqueued}
+	q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer q.ShutDown()
+
+	ctx := context.Background()
+	h.Create(ctx, event.CreateEvent{Object: other}, q)
+	enqueued := clk.Now()
+	h.Create(ctx, event.CreateEvent{Object: pod, IsInInitialList: true}, q)
+	clk.Step(time.Second)
+	h.Update(ctx, event.UpdateEvent{ObjectOld: pod, ObjectNew: pod}, q) // coalesced with the pending create
+	require.Equal(t, 2, q.Len())
+
+	process := func(obj *corev1.Pod) fwkdl.EventMeta {
+		t.Helper()
+		_, err := rn.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name}})
+		require.NoError(t, err)
+		events := ext.GetEvents()
+		require.NotEmpty(t, events)
+		return events[len(events)-1].Meta
+	}
+
+	assert.Equal(t, fwkdl.EventMeta{Sync: true, EnqueueTime: enqueued, QueueLength: 1}, process(pod),
+		"metadata of the first pending event should be delivered")
+	assert.Equal(t, fwkdl.EventMeta{EnqueueTime: enqueued, QueueLength: 0}, process(other))
+	assert.Zero(t, process(pod), "metadata is delivered once per enqueued event")
+}
+
+func TestNotificationEventMetaAbsent(t *testing.T) {
+	ext := newNotificationExtractors(1)[0]
+	src := NewFakeNotificationSource(t, podGVK, ext)
+	require.NoError(t, src.Push(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: newPodObject("pod")}))
+
+	require.Len(t, ext.GetEvents(), 1)
+	assert.Zero(t, ext.GetEvents()[0].Meta, "events not delivered by an informer carry no metadata")
+}
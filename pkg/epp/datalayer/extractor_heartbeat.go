@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// startHeartbeat logs every heartbeat interval that ext, started at start, is
+// still running, until the returned function is called. The function returns
+// once heartbeats stopped, so none is logged after the extractor returned.
+func (rn *notificationReconciler) startHeartbeat(log logr.Logger, ext fwkdl.NotificationExtractor, start time.Time) func() {
+	if rn.heartbeat <= 0 {
+		return func() {}
+	}
+
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			timer := rn.clock.NewTimer(rn.heartbeat)
+			select {
+			case <-done:
+				timer.Stop()
+				return
+			case <-timer.C():
+				select {
+				case <-done: // the extractor returned while the timer fired
+					return
+				default:
+				}
+				log.V(logging.DEBUG).Info("still running extractor", "extractor", ext.TypedName(),
+					"elapsed", rn.clock.Now().Sub(start))
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
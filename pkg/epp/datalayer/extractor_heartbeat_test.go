@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	testclock "k8s.io/utils/clock/testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
+)
+
+// stuckExtractor advances the clock past heartbeat intervals while running,
+// until it observed the given number of heartbeats.
+type stuckExtractor struct {
+	fwkdl.NotificationExtractor
+	clk        *testclock.FakeClock
+	interval   time.Duration
+	heartbeats chan string
+	want       int
+	seen       []string
+}
+
+func (s *stuckExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	for range s.want {
+		for !s.clk.HasWaiters() {
+			time.Sleep(time.Millisecond)
+		}
+		s.clk.Step(s.interval)
+		select {
+		case line := <-s.heartbeats:
+			s.seen = append(s.seen, line)
+		case <-time.After(5 * time.Second):
+			return context.DeadlineExceeded
+		}
+	}
+	return s.NotificationExtractor.ExtractNotification(ctx, event)
+}
+
+func TestNotificationReconcilerHeartbeat(t *testing.T) {
+	heartbeats := make(chan string, 10)
+	log := funcr.New(func(_, args string) {
+		if strings.Contains(args, "still running extractor") {
+			heartbeats <- args
+		}
+	}, funcr.Options{Verbosity: logging.DEBUG})
+
+	clk := testclock.NewFakeClock(time.Now())
+	ext := &stuckExtractor{
+		NotificationExtractor: extractormocks.NewNotificationExtractor("stuck"),
+		clk:                   clk,
+		interval:              time.Second,
+		heartbeats:            heartbeats,
+		want:                  2,
+	}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithExtractorHeartbeat(time.Second))
+
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, log)
+	rn.clock = clk
+	_, err := rn.dispatch(context.Background(), log, &fwkdl.NotificationEvent{
+		Type:   fwkdl.EventAddOrUpdate,
+		Object: &unstructured.Unstructured{},
+	})
+	require.NoError(t, err)
+	require.Len(t, ext.NotificationExtractor.(*extractormocks.NotificationExtractor).GetEvents(), 1)
+	require.Len(t, ext.seen, 2)
+	assert.Contains(t, ext.seen[0], ext.TypedName().String())
+	assert.Contains(t, ext.seen[1], `"elapsed"="2s"`)
+
+	assert.False(t, clk.HasWaiters(), "heartbeats should stop once the extractor returned")
+	clk.Step(time.Minute)
+	assert.Empty(t, heartbeats)
+}
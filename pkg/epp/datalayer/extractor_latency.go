@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"sync"
+	"time"
+)
+
+// extractorLatency accumulates the time spent in each extractor of a source,
+// referenced by index. It is safe for concurrent use.
+type extractorLatency struct {
+	mu    sync.Mutex
+	total []time.Duration
+	calls []int
+}
+
+func newExtractorLatency(n int) *extractorLatency {
+	return &extractorLatency{total: make([]time.Duration, n), calls: make([]int, n)}
+}
+
+// observe records one invocation of extractor i.
+func (l *extractorLatency) observe(i int, d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.total[i] += d
+	l.calls[i]++
+}
+
+// cumulative returns the total time spent in extractor i.
+func (l *extractorLatency) cumulative(i int) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.total[i]
+}
+
+// average returns the mean invocation time of extractor i; zero if never invoked.
+func (l *extractorLatency) average(i int) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.calls[i] == 0 {
+		return 0
+	}
+	return l.total[i] / time.Duration(l.calls[i])
+}
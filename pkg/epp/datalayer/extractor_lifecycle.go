@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"time"
+
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// ExtractorLifecycleAction identifies a change to the extractors of a source.
+type ExtractorLifecycleAction string
+
+const (
+	// ExtractorAdded reports an extractor attached with AddExtractor or
+	// AddOrReplaceExtractor, including one replacing an extractor of the same name.
+	ExtractorAdded ExtractorLifecycleAction = "added"
+	// ExtractorRemoved reports an extractor detached with RemoveExtractor.
+	ExtractorRemoved ExtractorLifecycleAction = "removed"
+)
+
+// ExtractorLifecycleEvent describes an extractor attached to or detached from a
+// source after the Runtime was configured.
+type ExtractorLifecycleEvent struct {
+	Action    ExtractorLifecycleAction
+	Source    fwkplugin.TypedName
+	Extractor fwkplugin.TypedName
+	Replaced  bool // for ExtractorAdded: an extractor with the same name was replaced
+	Time      time.Time
+}
+
+// ExtractorLifecycleHook is called after each extractor lifecycle event. It runs
+// synchronously on the registering goroutine, after the change is visible, and
+// may call back into the Runtime.
+type ExtractorLifecycleHook func(ExtractorLifecycleEvent)
+
+// SetExtractorLifecycleHook sets the hook called on extractor lifecycle events;
+// nil removes it. Events are logged regardless of the hook.
+func (r *Runtime) SetExtractorLifecycleHook(hook ExtractorLifecycleHook) {
+	r.extractorsMu.Lock()
+	defer r.extractorsMu.Unlock()
+	r.lifecycleHook = hook
+}
+
+// newLifecycleEvent logs the event and returns a function invoking the hook
+// with it. The caller must hold extractorsMu, and call the returned function
+// once the lock is released.
+func (r *Runtime) newLifecycleEvent(action ExtractorLifecycleAction, src, ext fwkplugin.TypedName, replaced bool) func() {
+	event := ExtractorLifecycleEvent{Action: action, Source: src, Extractor: ext, Replaced: replaced, Time: defaultClock.Now()}
+	r.logger.Info("Extractor lifecycle event", "action", event.Action, "source", event.Source,
+		"extractor", event.Extractor, "replaced", event.Replaced, "time", event.Time)
+
+	hook := r.lifecycleHook
+	if hook == nil {
+		return func() {}
+	}
+	return func() { hook(event) }
+}
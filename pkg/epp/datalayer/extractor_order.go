@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// dependsOnOf returns the dependencies of ext, if it is a DependentExtractor, for
+// decorators to forward those of the extractor they wrap.
+func dependsOnOf(ext fwkdl.Extractor) []string {
+	if dep, ok := ext.(fwkdl.DependentExtractor); ok {
+		return dep.DependsOn()
+	}
+	return nil
+}
+
+// orderExtractors returns the extractors sorted so that every DependentExtractor
+// comes after the extractors it depends on. The sort is stable: extractors keep
+// their configured relative order unless a dependency requires otherwise.
+// Returns an error on unknown dependencies or dependency cycles.
+func orderExtractors(extractors []fwkdl.Extractor) ([]fwkdl.Extractor, error) {
+	hasDependencies := false
+	for _, ext := range extractors {
+		if _, ok := ext.(fwkdl.DependentExtractor); ok {
+			hasDependencies = true
+			break
+		}
+	}
+	if !hasDependencies {
+		return extractors, nil
+	}
+
+	graph, err := newExtractorGraph(extractors)
+	if err != nil {
+		return nil, err
+	}
+	order, cycle := graph.order(nil)
+	if len(cycle) > 0 {
+		names := make([]string, len(cycle))
+		for i, idx := range cycle {
+			names[i] = extractors[idx].TypedName().Name
+		}
+		return nil, fmt.Errorf("dependency cycle between extractors %v", names)
+	}
+
+	ordered := make([]fwkdl.Extractor, len(order))
+	for i, idx := range order {
+		ordered[i] = extractors[idx]
+	}
+	return ordered, nil
+}
+
+// extractorGraph is the dependency graph of a list of extractors, referenced by
+// their index in the list.
+type extractorGraph struct {
+	inDegree   []int   // number of dependencies of each extractor
+	dependents [][]int // extractors waiting on each extractor
+}
+
+// newExtractorGraph builds the dependency graph of the extractors. Returns an
+// error on duplicate names, unknown dependencies and self-dependencies.
+func newExtractorGraph(extractors []fwkdl.Extractor) (*extractorGraph, error) {
+	index := make(map[string]int, len(extractors))
+	for i, ext := range extractors {
+		name := ext.TypedName().Name
+		if _, dup := index[name]; dup {
+			return nil, fmt.Errorf("duplicate extractor name %q", name)
+		}
+		index[name] = i
+	}
+
+	g := &extractorGraph{
+		inDegree:   make([]int, len(extractors)),
+		dependents: make([][]int, len(extractors)),
+	}
+	for i, ext := range extractors {
+		dep, ok := ext.(fwkdl.DependentExtractor)
+		if !ok {
+			continue
+		}
+		for _, name := range dep.DependsOn() {
+			j, found := index[name]
+			if !found {
+				return nil, fmt.Errorf("extractor %s depends on unknown extractor %q", ext.TypedName(), name)
+			}
+			if j == i {
+				return nil, fmt.Errorf("extractor %s depends on itself", ext.TypedName())
+			}
+			g.inDegree[i]++
+			g.dependents[j] = append(g.dependents[j], i)
+		}
+	}
+	return g, nil
+}
+
+// order returns the extractor indices in dependency order. Among extractors whose
+// dependencies are satisfied, the one with the lowest rank comes first, ties going
+// to the lowest index; a nil rank keeps the configured order. If the graph has a
+// cycle, the indices of the extractors that could not be ordered are returned as
+// the second value.
+func (g *extractorGraph) order(rank func(i int) time.Duration) ([]int, []int) {
+	inDegree := slices.Clone(g.inDegree)
+	done := make([]bool, len(inDegree))
+	ordered := make([]int, 0, len(inDegree))
+	for len(ordered) < len(inDegree) {
+		next := -1
+		for i := range inDegree {
+			if done[i] || inDegree[i] > 0 {
+				continue
+			}
+			if next < 0 || (rank != nil && rank(i) < rank(next)) {
+				next = i
+			}
+			if rank == nil {
+				break
+			}
+		}
+		if next < 0 {
+			var cycle []int
+			for i := range inDegree {
+				if !done[i] {
+					cycle = append(cycle, i)
+				}
+			}
+			return ordered, cycle
+		}
+		done[next] = true
+		ordered = append(ordered, next)
+		for _, d := range g.dependents[next] {
+			inDegree[d]--
+		}
+	}
+	return ordered, nil
+}
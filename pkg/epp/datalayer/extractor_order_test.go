@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/mocks"
+)
+
+// dependentExtractor is a notification extractor declaring dependencies.
+type dependentExtractor struct {
+	*extractormocks.NotificationExtractor
+	deps []string
+}
+
+func newDependentExtractor(name string, deps ...string) *dependentExtractor {
+	return &dependentExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor(name), deps: deps}
+}
+
+func (d *dependentExtractor) DependsOn() []string {
+	return d.deps
+}
+
+func extractorNames(exts []fwkdl.Extractor) []string {
+	names := make([]string, len(exts))
+	for i, ext := range exts {
+		names[i] = ext.TypedName().Name
+	}
+	return names
+}
+
+func TestOrderExtractors(t *testing.T) {
+	tests := []struct {
+		name       string
+		extractors []fwkdl.Extractor
+		want       []string
+		wantErr    string
+	}{
+		{
+			name: "no dependencies keeps configured order",
+			extractors: []fwkdl.Extractor{
+				extractormocks.NewNotificationExtractor("b"),
+				extractormocks.NewNotificationExtractor("a"),
+			},
+			want: []string{"b", "a"},
+		},
+		{
+			name: "dependency chain",
+			extractors: []fwkdl.Extractor{
+				newDependentExtractor("c", "b"),
+				newDependentExtractor("b", "a"),
+				extractormocks.NewNotificationExtractor("a"),
+				extractormocks.NewNotificationExtractor("d"),
+			},
+			want: []string{"a", "b", "c", "d"},
+		},
+		{
+			name: "unknown dependency",
+			extractors: []fwkdl.Extractor{
+				newDependentExtractor("a", "missing"),
+			},
+			wantErr: "unknown extractor",
+		},
+		{
+			name: "self dependency",
+			extractors: []fwkdl.Extractor{
+				newDependentExtractor("a", "a"),
+			},
+			wantErr: "depends on itself",
+		},
+		{
+			name: "cycle",
+			extractors: []fwkdl.Extractor{
+				extractormocks.NewNotificationExtractor("root"),
+				newDependentExtractor("a", "b"),
+				newDependentExtractor("b", "a"),
+			},
+			wantErr: "dependency cycle between extractors [a b]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := orderExtractors(tt.extractors)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, extractorNames(got))
+		})
+	}
+}
+
+func TestRuntimeConfigureOrdersDependentExtractors(t *testing.T) {
+	src := mocks.NewNotificationSource("test", "pods", podGVK)
+
+	r := NewRuntime(1)
+	require.NoError(t, r.Configure(&Config{
+		Sources: []DataSourceConfig{{
+			Plugin:     src,
+			Extractors: []fwkdl.Extractor{newDependentExtractor("second", "first"), newDependentExtractor("first")},
+		}},
+	}, false, "", newTestLogger(t)))
+
+	stored, ok := r.sourceExtractors.Load("pods")
+	require.True(t, ok)
+	assert.Equal(t, []string{"first", "second"}, extractorNames(stored.([]fwkdl.Extractor)))
+}
+
+func TestRuntimeConfigureRejectsDependencyCycle(t *testing.T) {
+	src := mocks.NewNotificationSource("test", "pods", podGVK)
+
+	r := NewRuntime(1)
+	err := r.Configure(&Config{
+		Sources: []DataSourceConfig{{
+			Plugin:     src,
+			Extractors: []fwkdl.Extractor{newDependentExtractor("a", "b"), newDependentExtractor("b", "a")},
+		}},
+	}, false, "", newTestLogger(t))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dependency cycle")
+}
@@ -59,3 +59,16 @@ func TestFactory(t *testing.T) {
 	// This test would need modification to verify polling - for now just verify it works
 	_ = endpoint2
 }
+
+func TestFactoryUnnamedEndpoints(t *testing.T) {
+	runtime := NewTestRuntime(t, 100*time.Millisecond)
+
+	// endpoints without a name are told apart by their address.
+	endpoint1 := runtime.NewEndpoint(context.Background(), &fwkdl.EndpointMetadata{Address: "1.2.3.4", Port: "5678"}, nil)
+	assert.NotNil(t, endpoint1, "failed to create endpoint")
+	endpoint2 := runtime.NewEndpoint(context.Background(), &fwkdl.EndpointMetadata{Address: "1.2.3.4", Port: "5679"}, nil)
+	assert.NotNil(t, endpoint2, "expected a distinct collector for another address")
+
+	runtime.ReleaseEndpoint(endpoint1)
+	runtime.ReleaseEndpoint(endpoint2)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"cmp"
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const fakeNotificationSourceType = "fake-notification-source"
+
+var _ fwkdl.NotificationSource = (*FakeNotificationSource)(nil)
+
+// FakeNotificationSource is a NotificationSource for testing NotificationExtractors
+// without standing up informers. Events injected with Push go through the same
+// dispatch path the framework core uses for cache events, and every extractor
+// invocation and error is recorded for assertions. The extractors are handed to
+// the core as is, so their optional interfaces, e.g., DependentExtractor, apply.
+type FakeNotificationSource struct {
+	typedName  fwkplugin.TypedName
+	gvk        schema.GroupVersionKind
+	reconciler *notificationReconciler
+
+	mu          sync.Mutex
+	invocations map[string][]fwkdl.NotificationEvent // key=extractor TypedName string
+	errors      map[string][]error                   // key=extractor TypedName string
+}
+
+// NewFakeNotificationSource creates a fake source for the given GVK with the
+// extractors bound to it, in dependency order as the Runtime registers them, and
+// dispatched sequentially (see WithDispatchStrategy). Dispatch logging is routed
+// to the test log.
+func NewFakeNotificationSource(t *testing.T, gvk schema.GroupVersionKind, extractors ...fwkdl.NotificationExtractor) *FakeNotificationSource {
+	t.Helper()
+	src := &FakeNotificationSource{
+		typedName:   fwkplugin.TypedName{Type: fakeNotificationSourceType, Name: gvk.String()},
+		gvk:         gvk,
+		invocations: make(map[string][]fwkdl.NotificationEvent),
+		errors:      make(map[string][]error),
+	}
+
+	exts := make([]fwkdl.Extractor, len(extractors))
+	for i, ext := range extractors {
+		exts[i] = ext
+	}
+	ordered, err := orderExtractors(exts)
+	if err != nil {
+		t.Fatalf("invalid extractors: %v", err)
+	}
+	bound := make([]fwkdl.NotificationExtractor, len(ordered))
+	for i, ext := range ordered {
+		bound[i] = ext.(fwkdl.NotificationExtractor)
+	}
+	src.reconciler = newNotificationReconciler(nil, src, bound, newTestLogger(t))
+	src.reconciler.observeExtraction = src.record
+	return src
+}
+
+// WithDispatchStrategy makes the source dispatch its events with strategy, e.g.,
+// CancellableDispatch to exercise asynchronous dispatch, instead of sequentially.
+func (f *FakeNotificationSource) WithDispatchStrategy(strategy fwkdl.DispatchStrategy) *FakeNotificationSource {
+	f.reconciler.strategy = cmp.Or[fwkdl.DispatchStrategy](strategy, fwkdl.SequentialDispatch)
+	return f
+}
+
+// TypedName returns the plugin type and name.
+func (f *FakeNotificationSource) TypedName() fwkplugin.TypedName {
+	return f.typedName
+}
+
+// GVK returns the GroupVersionKind this source serves.
+func (f *FakeNotificationSource) GVK() schema.GroupVersionKind {
+	return f.gvk
+}
+
+// OutputType returns the type of data this DataSource produces (NotificationEvent).
+func (f *FakeNotificationSource) OutputType() reflect.Type {
+	return fwkdl.NotificationEventType
+}
+
+// ExtractorType returns the type of Extractor this DataSource expects (NotificationExtractor).
+func (f *FakeNotificationSource) ExtractorType() reflect.Type {
+	return fwkdl.NotificationExtractorType
+}
+
+// Notify passes the event through unchanged.
+func (f *FakeNotificationSource) Notify(_ context.Context, event fwkdl.NotificationEvent) (*fwkdl.NotificationEvent, error) {
+	return &event, nil
+}
+
+// Push delivers a synthetic event to the bound extractors. As with cache events,
+// the object is deep-copied before delivery so the caller's copy is never shared.
+// Returns the error the dispatch path reports to the controller, if any.
+func (f *FakeNotificationSource) Push(ctx context.Context, event fwkdl.NotificationEvent) error {
+	if event.Object != nil {
+		event.Object = event.Object.DeepCopy()
+	}
+	_, err := f.reconciler.dispatch(ctx, f.reconciler.log, &event)
+	return err
+}
+
+// Invocations returns the events delivered to the named extractor, in order.
+// The name is the extractor's TypedName().String().
+func (f *FakeNotificationSource) Invocations(extractor string) []fwkdl.NotificationEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]fwkdl.NotificationEvent(nil), f.invocations[extractor]...)
+}
+
+// Errors returns the non-nil errors returned by the named extractor, in order.
+// The name is the extractor's TypedName().String().
+func (f *FakeNotificationSource) Errors(extractor string) []error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]error(nil), f.errors[extractor]...)
+}
+
+// record records an invocation of the extractor at index in the reconciler.
+func (f *FakeNotificationSource) record(index int, event fwkdl.NotificationEvent, err error) {
+	key := f.reconciler.extractors[index].TypedName().String()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.invocations[key] = append(f.invocations[key], event)
+	if err != nil {
+		f.errors[key] = append(f.errors[key], err)
+	}
+}
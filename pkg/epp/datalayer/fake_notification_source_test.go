@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+)
+
+func newPodObject(name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(podGVK)
+	obj.SetNamespace("default")
+	obj.SetName(name)
+	return obj
+}
+
+func TestFakeNotificationSourceDelivers(t *testing.T) {
+	ext := extractormocks.NewNotificationExtractor("pods")
+	src := NewFakeNotificationSource(t, podGVK, ext)
+
+	obj := newPodObject("pod-a")
+	require.NoError(t, src.Push(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj}))
+	require.NoError(t, src.Push(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventDelete, Object: obj}))
+
+	events := ext.GetEvents()
+	require.Len(t, events, 2)
+	assert.Equal(t, fwkdl.EventAddOrUpdate, events[0].Type)
+	assert.Equal(t, fwkdl.EventDelete, events[1].Type)
+	assert.Equal(t, "pod-a", events[0].Object.GetName())
+
+	// the injected object is copied, as the framework core does for cache events.
+	events[0].Object.SetName("mutated")
+	assert.Equal(t, "pod-a", obj.GetName())
+
+	invocations := src.Invocations(ext.TypedName().String())
+	require.Len(t, invocations, 2)
+	assert.Empty(t, src.Errors(ext.TypedName().String()))
+}
+
+func TestFakeNotificationSourceRecordsErrors(t *testing.T) {
+	errExtract := errors.New("extract failed")
+	good := extractormocks.NewNotificationExtractor("good")
+	bad := extractormocks.NewNotificationExtractor("bad").WithExtractError(errExtract)
+	src := NewFakeNotificationSource(t, podGVK, good, bad)
+
+	// extractor errors are logged, not propagated to the controller.
+	require.NoError(t, src.Push(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: newPodObject("pod-a")}))
+
+	assert.Len(t, src.Invocations(good.TypedName().String()), 1)
+	assert.Empty(t, src.Errors(good.TypedName().String()))
+	assert.Len(t, src.Invocations(bad.TypedName().String()), 1)
+	assert.Equal(t, []error{errExtract}, src.Errors(bad.TypedName().String()))
+}
+
+func TestFakeNotificationSourceOrdersDependentExtractors(t *testing.T) {
+	dependent := newDependentExtractor("dependent", "base")
+	base := extractormocks.NewNotificationExtractor("base")
+	src := NewFakeNotificationSource(t, podGVK, dependent, base)
+
+	require.Len(t, src.reconciler.extractors, 2)
+	assert.Equal(t, "base", src.reconciler.extractors[0].TypedName().Name)
+	assert.Equal(t, "dependent", src.reconciler.extractors[1].TypedName().Name)
+
+	require.NoError(t, src.Push(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: newPodObject("pod-a")}))
+	assert.Len(t, src.Invocations(dependent.TypedName().String()), 1)
+	assert.Len(t, src.Invocations(base.TypedName().String()), 1)
+}
+
+func TestFakeNotificationSourceDispatchStrategy(t *testing.T) {
+	stuck := &gatedExtractor{
+		NotificationExtractor: extractormocks.NewNotificationExtractor("stuck"),
+		started:               make(chan struct{}),
+		release:               make(chan struct{}),
+	}
+	src := NewFakeNotificationSource(t, podGVK, stuck).
+		WithDispatchStrategy(CancellableDispatch(fwkdl.SequentialDispatch))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stuck.started
+		cancel()
+	}()
+	// the cancelled push returns while the extractor is still running.
+	require.NoError(t, src.Push(ctx, fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: newPodObject("pod-a")}))
+	assert.Empty(t, src.Invocations(stuck.TypedName().String()))
+
+	close(stuck.release)
+	require.Eventually(t, func() bool {
+		return len(src.Invocations(stuck.TypedName().String())) == 1
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// ItemsFunc returns the list items held by a parent object.
+type ItemsFunc func(parent *unstructured.Unstructured) ([]*unstructured.Unstructured, error)
+
+// ItemKeyFunc identifies a list item across updates of its parent.
+type ItemKeyFunc func(item *unstructured.Unstructured) (string, error)
+
+// FieldItems returns an ItemsFunc reading the list at the given field path, e.g.,
+// FieldItems("status", "endpoints"). A missing field is an empty list; entries
+// that are not objects are an error.
+func FieldItems(fields ...string) ItemsFunc {
+	path := strings.Join(fields, ".")
+	return func(parent *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+		list, _, err := unstructured.NestedSlice(parent.Object, fields...)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]*unstructured.Unstructured, len(list))
+		for i, entry := range list {
+			obj, ok := entry.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s[%d] is %T, not an object", path, i, entry)
+			}
+			items[i] = &unstructured.Unstructured{Object: obj}
+		}
+		return items, nil
+	}
+}
+
+// FieldKey returns an ItemKeyFunc reading the string at the given field path of
+// the item, e.g., FieldKey("address").
+func FieldKey(fields ...string) ItemKeyFunc {
+	path := strings.Join(fields, ".")
+	return func(item *unstructured.Unstructured) (string, error) {
+		key, found, err := unstructured.NestedString(item.Object, fields...)
+		if err != nil {
+			return "", err
+		}
+		if !found || key == "" {
+			return "", fmt.Errorf("list item has no %s", path)
+		}
+		return key, nil
+	}
+}
+
+// FanOutExtractor decorates a NotificationExtractor to receive one event per
+// item of a list held by the watched (parent) object, instead of the parent
+// itself. Items are diffed against the previous state of the same parent: new
+// and changed items are delivered as EventAddOrUpdate, removed items as
+// EventDelete. Deleting the parent deletes all its items. The synthetic event
+// objects are the list items, not full k8s objects; the events carry the Meta of
+// the parent event, and its Typed object, i.e., the parent decoded, if any.
+type FanOutExtractor struct {
+	fwkdl.NotificationExtractor
+	items ItemsFunc
+	key   ItemKeyFunc
+
+	mu    sync.Mutex
+	state map[types.NamespacedName]map[string]*unstructured.Unstructured // last items, by parent and key
+}
+
+var _ fwkdl.NotificationExtractor = (*FanOutExtractor)(nil)
+
+// NewFanOutExtractor wraps ext so it receives the items returned by items,
+// identified by key.
+func NewFanOutExtractor(ext fwkdl.NotificationExtractor, items ItemsFunc, key ItemKeyFunc) *FanOutExtractor {
+	return &FanOutExtractor{
+		NotificationExtractor: ext,
+		items:                 items,
+		key:                   key,
+		state:                 make(map[types.NamespacedName]map[string]*unstructured.Unstructured),
+	}
+}
+
+// DependsOn implements fwkdl.DependentExtractor.
+func (f *FanOutExtractor) DependsOn() []string {
+	return dependsOnOf(f.NotificationExtractor)
+}
+
+// ExtractNotification diffs the parent's items against its previous state and
+// forwards an event per added, changed or removed item. All item events are
+// delivered even if some fail; the errors are joined. If the items cannot be
+// read, the previous state is kept and nothing is forwarded.
+func (f *FanOutExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	if event.Object == nil {
+		return fmt.Errorf("fan-out of %s: event has no object", f.TypedName())
+	}
+	parent := types.NamespacedName{Namespace: event.Namespace(), Name: event.Name()}
+
+	current := map[string]*unstructured.Unstructured{}
+	if event.Type != fwkdl.EventDelete {
+		items, err := f.items(event.Object)
+		if err != nil {
+			return fmt.Errorf("failed to read items of %s: %w", parent, err)
+		}
+		for _, item := range items {
+			k, err := f.key(item)
+			if err != nil {
+				return fmt.Errorf("failed to key item of %s: %w", parent, err)
+			}
+			current[k] = item
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	previous := f.state[parent]
+
+	var errs []error
+	forward := func(eventType fwkdl.EventType, item *unstructured.Unstructured) {
+		child := fwkdl.NotificationEvent{Type: eventType, Object: item, Typed: event.Typed, Meta: event.Meta}
+		if err := f.NotificationExtractor.ExtractNotification(ctx, child); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	// sorted keys for a deterministic delivery order.
+	for _, k := range slices.Sorted(maps.Keys(previous)) {
+		if _, ok := current[k]; !ok {
+			forward(fwkdl.EventDelete, previous[k])
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(current)) {
+		if old, ok := previous[k]; !ok || !equality.Semantic.DeepEqual(old.Object, current[k].Object) {
+			forward(fwkdl.EventAddOrUpdate, current[k])
+		}
+	}
+
+	if len(current) == 0 {
+		delete(f.state, parent)
+	} else {
+		f.state[parent] = current
+	}
+	return errors.Join(errs...)
+}
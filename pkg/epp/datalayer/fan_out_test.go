@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+)
+
+// newParent returns a parent object listing endpoints with the given address:port pairs.
+func newParent(endpoints map[string]int64) *unstructured.Unstructured {
+	list := []any{}
+	for addr, port := range endpoints {
+		list = append(list, map[string]any{"address": addr, "port": port})
+	}
+	parent := &unstructured.Unstructured{Object: map[string]any{}}
+	parent.SetNamespace("default")
+	parent.SetName("pool")
+	if err := unstructured.SetNestedSlice(parent.Object, list, "status", "endpoints"); err != nil {
+		panic(err)
+	}
+	return parent
+}
+
+type itemEvent struct {
+	eventType fwkdl.EventType
+	address   string
+	port      int64
+}
+
+func itemEvents(t *testing.T, events []fwkdl.NotificationEvent) []itemEvent {
+	t.Helper()
+	result := make([]itemEvent, len(events))
+	for i, e := range events {
+		addr, _, err := unstructured.NestedString(e.Object.Object, "address")
+		require.NoError(t, err)
+		port, _, err := unstructured.NestedInt64(e.Object.Object, "port")
+		require.NoError(t, err)
+		result[i] = itemEvent{eventType: e.Type, address: addr, port: port}
+	}
+	return result
+}
+
+func TestFanOutExtractor(t *testing.T) {
+	inner := extractormocks.NewNotificationExtractor("endpoints")
+	fanOut := NewFanOutExtractor(inner, FieldItems("status", "endpoints"), FieldKey("address"))
+	ctx := context.Background()
+
+	require.NoError(t, fanOut.ExtractNotification(ctx, fwkdl.NotificationEvent{
+		Type:   fwkdl.EventAddOrUpdate,
+		Object: newParent(map[string]int64{"10.0.0.1": 8000, "10.0.0.2": 8000, "10.0.0.4": 8000}),
+	}))
+	assert.Equal(t, []itemEvent{
+		{fwkdl.EventAddOrUpdate, "10.0.0.1", 8000},
+		{fwkdl.EventAddOrUpdate, "10.0.0.2", 8000},
+		{fwkdl.EventAddOrUpdate, "10.0.0.4", 8000},
+	}, itemEvents(t, inner.GetEvents()))
+
+	// 10.0.0.1 was removed, 10.0.0.2 changed port, 10.0.0.3 was added and
+	// 10.0.0.4 is unchanged.
+	require.NoError(t, fanOut.ExtractNotification(ctx, fwkdl.NotificationEvent{
+		Type:   fwkdl.EventAddOrUpdate,
+		Object: newParent(map[string]int64{"10.0.0.2": 9000, "10.0.0.3": 8000, "10.0.0.4": 8000}),
+	}))
+	assert.Equal(t, []itemEvent{
+		{fwkdl.EventDelete, "10.0.0.1", 8000},
+		{fwkdl.EventAddOrUpdate, "10.0.0.2", 9000},
+		{fwkdl.EventAddOrUpdate, "10.0.0.3", 8000},
+	}, itemEvents(t, inner.GetEvents()[3:]))
+
+	// deleting the parent deletes all its items.
+	deleted := &unstructured.Unstructured{}
+	deleted.SetNamespace("default")
+	deleted.SetName("pool")
+	require.NoError(t, fanOut.ExtractNotification(ctx, fwkdl.NotificationEvent{Type: fwkdl.EventDelete, Object: deleted}))
+	assert.Equal(t, []itemEvent{
+		{fwkdl.EventDelete, "10.0.0.2", 9000},
+		{fwkdl.EventDelete, "10.0.0.3", 8000},
+		{fwkdl.EventDelete, "10.0.0.4", 8000},
+	}, itemEvents(t, inner.GetEvents()[6:]))
+	assert.Empty(t, fanOut.state)
+}
+
+func TestFanOutExtractorInvalidItems(t *testing.T) {
+	inner := extractormocks.NewNotificationExtractor("endpoints")
+	fanOut := NewFanOutExtractor(inner, FieldItems("status", "endpoints"), FieldKey("address"))
+
+	parent := newParent(nil)
+	require.NoError(t, unstructured.SetNestedSlice(parent.Object, []any{map[string]any{"port": int64(80)}}, "status", "endpoints"))
+	err := fanOut.ExtractNotification(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: parent})
+	assert.ErrorContains(t, err, "list item has no address")
+
+	require.NoError(t, unstructured.SetNestedField(parent.Object, "not-a-list", "status", "endpoints"))
+	err = fanOut.ExtractNotification(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: parent})
+	assert.Error(t, err)
+	assert.Empty(t, inner.GetEvents())
+}
+
+func TestFanOutExtractorForwarding(t *testing.T) {
+	inner := newDependentExtractor("endpoints", "pods")
+	fanOut := NewFanOutExtractor(inner, FieldItems("status", "endpoints"), FieldKey("address"))
+	assert.Equal(t, []string{"pods"}, fanOut.DependsOn())
+
+	parent := newParent(map[string]int64{"10.0.0.1": 8000})
+	meta := fwkdl.EventMeta{Sync: true, Replayed: true, DryRun: true, QueueLength: 2}
+	require.NoError(t, fanOut.ExtractNotification(context.Background(), fwkdl.NotificationEvent{
+		Type:   fwkdl.EventAddOrUpdate,
+		Object: parent,
+		Typed:  parent,
+		Meta:   meta,
+	}))
+	events := inner.GetEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, meta, events[0].Meta)
+	assert.Same(t, parent, events[0].Typed)
+}
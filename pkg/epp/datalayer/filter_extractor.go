@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"fmt"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// errEventFiltered is returned by filter extractors for events not matching their
+// predicate.
+var errEventFiltered = fmt.Errorf("event filtered out: %w", fwkdl.ErrSkip)
+
+// FilterExtractor decorates a NotificationExtractor to only process the events
+// matching a predicate, e.g., objects in some namespaces, so that extractors of
+// the same source can be routed different events. Other events are skipped.
+type FilterExtractor struct {
+	fwkdl.NotificationExtractor
+	predicate fwkdl.EventPredicate
+}
+
+var _ fwkdl.NotificationExtractor = (*FilterExtractor)(nil)
+
+// NewFilterExtractor wraps ext to only process the events matching predicate.
+func NewFilterExtractor(ext fwkdl.NotificationExtractor, predicate fwkdl.EventPredicate) *FilterExtractor {
+	return &FilterExtractor{NotificationExtractor: ext, predicate: predicate}
+}
+
+// DependsOn implements fwkdl.DependentExtractor.
+func (f *FilterExtractor) DependsOn() []string {
+	return dependsOnOf(f.NotificationExtractor)
+}
+
+// ExtractNotification invokes the wrapped extractor if the event matches the
+// predicate.
+func (f *FilterExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	if !f.predicate(event) {
+		return errEventFiltered
+	}
+	return f.NotificationExtractor.ExtractNotification(ctx, event)
+}
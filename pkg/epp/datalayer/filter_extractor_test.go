@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+)
+
+func TestFilterExtractor(t *testing.T) {
+	inNamespace := func(ns string) fwkdl.EventPredicate {
+		return func(event fwkdl.NotificationEvent) bool { return event.Namespace() == ns }
+	}
+	event := func(ns string, eventType fwkdl.EventType) fwkdl.NotificationEvent {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace(ns)
+		obj.SetName("pod")
+		return fwkdl.NotificationEvent{Type: eventType, Object: obj}
+	}
+
+	inner := extractormocks.NewNotificationExtractor("pods")
+	ext := NewFilterExtractor(inner, fwkdl.And(
+		fwkdl.Or(inNamespace("prod"), inNamespace("staging")),
+		fwkdl.Not(func(event fwkdl.NotificationEvent) bool { return event.Type == fwkdl.EventDelete }),
+	))
+	assert.Equal(t, inner.TypedName(), ext.TypedName())
+
+	ctx := context.Background()
+	require.NoError(t, ext.ExtractNotification(ctx, event("prod", fwkdl.EventAddOrUpdate)))
+	require.NoError(t, ext.ExtractNotification(ctx, event("staging", fwkdl.EventAddOrUpdate)))
+	assert.ErrorIs(t, ext.ExtractNotification(ctx, event("dev", fwkdl.EventAddOrUpdate)), fwkdl.ErrSkip)
+	assert.ErrorIs(t, ext.ExtractNotification(ctx, event("prod", fwkdl.EventDelete)), fwkdl.ErrSkip)
+	assert.Len(t, inner.GetEvents(), 2, "only matching events should reach the extractor")
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// GatedExtractor decorates an Extractor to only run while a gate, such as a
+// feature gate, is open. The gate is evaluated on every call, so flipping it
+// takes effect on the next data or event without re-registering the extractor.
+// Calls made while the gate is closed return nil, and are counted by Skipped.
+type GatedExtractor struct {
+	fwkdl.Extractor
+	gate    func() bool
+	skipped atomic.Uint64
+}
+
+// NewGatedExtractor wraps ext so it only runs while gate returns true.
+func NewGatedExtractor(ext fwkdl.Extractor, gate func() bool) *GatedExtractor {
+	return &GatedExtractor{Extractor: ext, gate: gate}
+}
+
+// DependsOn implements fwkdl.DependentExtractor.
+func (g *GatedExtractor) DependsOn() []string {
+	return dependsOnOf(g.Extractor)
+}
+
+// Skipped returns the number of calls skipped so far while the gate was closed.
+func (g *GatedExtractor) Skipped() uint64 {
+	return g.skipped.Load()
+}
+
+// open evaluates the gate, counting the call as skipped if it is closed.
+func (g *GatedExtractor) open() bool {
+	if g.gate() {
+		return true
+	}
+	g.skipped.Add(1)
+	return false
+}
+
+// Extract invokes the wrapped extractor if the gate is open.
+func (g *GatedExtractor) Extract(ctx context.Context, data any, ep fwkdl.Endpoint) error {
+	if !g.open() {
+		return nil
+	}
+	return g.Extractor.Extract(ctx, data, ep)
+}
+
+// NotificationGatedExtractor is a GatedExtractor for NotificationExtractors.
+type NotificationGatedExtractor struct {
+	*GatedExtractor
+	notifier fwkdl.NotificationExtractor
+}
+
+var _ fwkdl.NotificationExtractor = (*NotificationGatedExtractor)(nil)
+
+// NewNotificationGatedExtractor wraps a NotificationExtractor with a gate covering
+// both Extract and ExtractNotification.
+func NewNotificationGatedExtractor(ext fwkdl.NotificationExtractor, gate func() bool) *NotificationGatedExtractor {
+	return &NotificationGatedExtractor{
+		GatedExtractor: NewGatedExtractor(ext, gate),
+		notifier:       ext,
+	}
+}
+
+// GVK returns the GroupVersionKind of the wrapped extractor.
+func (g *NotificationGatedExtractor) GVK() schema.GroupVersionKind {
+	return g.notifier.GVK()
+}
+
+// ExtractNotification invokes the wrapped extractor if the gate is open.
+func (g *NotificationGatedExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	if !g.open() {
+		return nil
+	}
+	return g.notifier.ExtractNotification(ctx, event)
+}
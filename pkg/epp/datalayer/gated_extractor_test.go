@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+)
+
+func TestGatedExtractor(t *testing.T) {
+	var enabled atomic.Bool
+	inner := extractormocks.NewPollingExtractor("gated")
+	gated := NewGatedExtractor(inner, enabled.Load)
+	ctx := context.Background()
+
+	assert.NoError(t, gated.Extract(ctx, nil, endpoint), "closed gate should skip")
+	assert.Equal(t, 0, inner.CallCount())
+	assert.Equal(t, uint64(1), gated.Skipped())
+
+	enabled.Store(true)
+	assert.NoError(t, gated.Extract(ctx, nil, endpoint))
+	assert.NoError(t, gated.Extract(ctx, nil, endpoint))
+	assert.Equal(t, 2, inner.CallCount())
+
+	enabled.Store(false)
+	assert.NoError(t, gated.Extract(ctx, nil, endpoint))
+	assert.Equal(t, 2, inner.CallCount(), "delivery should stop once the gate closes")
+	assert.Equal(t, uint64(2), gated.Skipped())
+}
+
+func TestNotificationGatedExtractor(t *testing.T) {
+	var enabled atomic.Bool
+	inner := extractormocks.NewNotificationExtractor("gated")
+	gated := NewNotificationGatedExtractor(inner, enabled.Load)
+	event := fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}}
+
+	assert.Equal(t, inner.GVK(), gated.GVK())
+	assert.Equal(t, inner.TypedName(), gated.TypedName())
+	assert.NoError(t, gated.ExtractNotification(context.Background(), event))
+	assert.Empty(t, inner.GetEvents())
+	assert.Equal(t, uint64(1), gated.Skipped())
+	enabled.Store(true)
+	assert.NoError(t, gated.ExtractNotification(context.Background(), event))
+	assert.Len(t, inner.GetEvents(), 1)
+	assert.Equal(t, uint64(1), gated.Skipped())
+}
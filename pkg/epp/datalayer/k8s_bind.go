@@ -18,20 +18,49 @@ package datalayer
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
 )
 
+// extractNotificationExtensionPoint labels notification extractor latencies in the
+// plugin processing latency metric.
+const extractNotificationExtensionPoint = "ExtractNotification"
+
+// unboundWarningSampling is the rate of the warnings logged for events of sources
+// without extractors: one per that many events.
+const unboundWarningSampling = 100
+
+// defaultMaxLoggedErrors is the number of extractor errors logged per event,
+// unless the source sets its own limit.
+const defaultMaxLoggedErrors = 10
+
 // BindNotificationSource registers a watcher/reconciler for the source's GVK.
 // The framework core owns the cache and reconciliation; the source only receives
 // deep-copied events via Notify.
@@ -39,16 +68,31 @@ func BindNotificationSource(src fwkdl.NotificationSource, extractors []fwkdl.Not
 	gvk := src.GVK()
 	log := mgr.GetLogger().WithName("notification-controller").WithValues("gvk", gvk.Kind)
 
-	reconciler := &notificationReconciler{
-		client:     mgr.GetClient(),
-		src:        src,
-		extractors: extractors,
-		gvk:        gvk,
-		log:        log,
+	var watch fwkdl.WatchOptions
+	if scoped, ok := src.(fwkdl.WatchOptionsSource); ok {
+		watch = scoped.WatchOptions()
+	}
+	informers, err := watchCache(mgr, watch)
+	if err != nil {
+		return err
 	}
 
+	// Objects are read from the informer cache: the manager's client reads
+	// unstructured objects from the API server, so every event would cost a
+	// request and the deep-copy opt-outs would have nothing to share.
+	reconciler := newNotificationReconciler(informers, src, extractors, log)
+	reconciler.enqueued = newEnqueueTracker(reconciler.clock)
+
 	obj := &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(gvk)
+	if reconciler.journal != nil {
+		// Live events wait for the replay, which runs with the manager, once extractors
+		// can read the cache.
+		reconciler.replayed = make(chan struct{})
+		if err := mgr.Add(replayOnInformerSync(informers, obj, reconciler)); err != nil {
+			return err
+		}
+	}
 
 	// use the source's name to make the controller name unique
 	// This allows multiple notification sources for the same GVK
@@ -56,28 +100,293 @@ func BindNotificationSource(src fwkdl.NotificationSource, extractors []fwkdl.Not
 	// one source per GVK).
 	controllerName := "notify_" + strings.ToLower(gvk.Kind) + "_" + src.TypedName().Name
 
-	return ctrl.NewControllerManagedBy(mgr).
+	h := &metaRecordingHandler{tracker: reconciler.enqueued}
+	bldr := ctrl.NewControllerManagedBy(mgr).
 		// Naming the controller allows you to see specific metrics/logs for this watch
 		Named(controllerName).
-		For(obj).
-		// ResourceVersionChanged is safer for generic notifications than GenerationChanged,
-		// as it catches metadata and status updates that the consumer might need.
-		WithEventFilter(predicate.ResourceVersionChangedPredicate{}).
-		Complete(reconciler)
+		// Watching with our own handler rather than For() records the delivery
+		// metadata of each event (see fwkdl.EventMeta), and watching the cache of
+		// the source rather than the manager's honors its WatchOptions.
+		WatchesRawSource(source.Kind[client.Object](informers, obj, h,
+			// ResourceVersionChanged is safer for generic notifications than GenerationChanged,
+			// as it catches metadata and status updates that the consumer might need.
+			predicate.ResourceVersionChangedPredicate{},
+			watchPredicate(reconciler.watch)))
+	if resync, ok := src.(fwkdl.ResyncSource); ok && resync.ResyncPeriod() > 0 {
+		bldr = bldr.WatchesRawSource(resyncSource(informers, obj, resync.ResyncPeriod(), h, reconciler.watch))
+	}
+	if indexing, ok := src.(fwkdl.IndexingSource); ok && len(indexing.Indexes()) > 0 {
+		if err := registerIndexes(context.Background(), informers, obj, src.TypedName().Name, indexing.Indexes()); err != nil {
+			return err
+		}
+		// The cache, rather than the client, serves unstructured reads from the informer.
+		indexing.SetLookup(newIndexLookup(informers, gvk, src.TypedName().Name))
+	}
+	if err := bldr.Complete(reconciler); err != nil {
+		return err
+	}
+
+	if syncAware, ok := src.(fwkdl.SyncAwareSource); ok {
+		return mgr.Add(markSyncedOnInformerSync(informers, obj, syncAware))
+	}
+	return nil
+}
+
+// watchCache returns the cache of the objects watched with opts: the manager's
+// cache, unless opts scope the objects, in which case a cache of its own, started
+// with the manager, lists and watches only the selected objects.
+func watchCache(mgr ctrl.Manager, opts fwkdl.WatchOptions) (cache.Cache, error) {
+	cacheOpts, scoped := watchCacheOptions(opts)
+	if !scoped {
+		return mgr.GetCache(), nil
+	}
+	cacheOpts.Scheme, cacheOpts.Mapper, cacheOpts.HTTPClient = mgr.GetScheme(), mgr.GetRESTMapper(), mgr.GetHTTPClient()
+	c, err := cache.New(mgr.GetConfig(), cacheOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the cache of the watch: %w", err)
+	}
+	if err := mgr.Add(c); err != nil {
+		return nil, fmt.Errorf("failed to add the cache of the watch: %w", err)
+	}
+	return c, nil
+}
+
+// watchCacheOptions returns the options of a cache restricted to the objects
+// selected by opts, and whether opts select only some objects.
+func watchCacheOptions(opts fwkdl.WatchOptions) (cache.Options, bool) {
+	var cacheOpts cache.Options
+	if len(opts.Namespaces) > 0 {
+		cacheOpts.DefaultNamespaces = make(map[string]cache.Config, len(opts.Namespaces))
+		for _, ns := range opts.Namespaces {
+			cacheOpts.DefaultNamespaces[ns] = cache.Config{}
+		}
+	}
+	if opts.LabelSelector != nil && !opts.LabelSelector.Empty() {
+		cacheOpts.DefaultLabelSelector = opts.LabelSelector
+	}
+	return cacheOpts, cacheOpts.DefaultNamespaces != nil || cacheOpts.DefaultLabelSelector != nil
+}
+
+// watchPredicate admits the events of objects selected by opts. Updates are
+// admitted if either version is selected, so that objects leaving the scope are
+// reconciled, and reported deleted.
+func watchPredicate(opts fwkdl.WatchOptions) predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return opts.Selects(e.Object) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return opts.Selects(e.ObjectOld) || opts.Selects(e.ObjectNew) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return opts.Selects(e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return opts.Selects(e.Object) },
+	}
+}
+
+// resyncSource returns a source enqueueing the objects of the informer of obj
+// every period, as the informer resyncs them. The watch filters resynced objects
+// out, as their resource version is unchanged, so this source only enqueues
+// those, and leaves the changes to the watch.
+func resyncSource(informers cache.Informers, obj client.Object, period time.Duration,
+	h *metaRecordingHandler, watch fwkdl.WatchOptions) source.Source {
+	return source.Func(func(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		informer, err := informers.GetInformer(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("failed to get informer for %s: %w", obj.GetObjectKind().GroupVersionKind(), err)
+		}
+		_, err = informer.AddEventHandlerWithResyncPeriod(toolscache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj any) {
+				prev, okPrev := oldObj.(client.Object)
+				next, okNext := newObj.(client.Object)
+				if !okPrev || !okNext || prev.GetResourceVersion() != next.GetResourceVersion() || !watch.Selects(next) {
+					return
+				}
+				h.Update(ctx, event.UpdateEvent{ObjectOld: prev, ObjectNew: next}, q)
+			},
+		}, period)
+		return err
+	})
+}
+
+// markSyncedOnInformerSync returns a Runnable which waits for the informer of obj
+// to complete its initial list and then marks the source as synced.
+func markSyncedOnInformerSync(informers cache.Informers, obj client.Object, src fwkdl.SyncAwareSource) manager.RunnableFunc {
+	return func(ctx context.Context) error {
+		informer, err := informers.GetInformer(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("failed to get informer for %s: %w", obj.GetObjectKind().GroupVersionKind(), err)
+		}
+		if toolscache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+			src.SetSynced()
+		}
+		return nil
+	}
+}
+
+// replayOnInformerSync returns a Runnable which waits for the informer of obj to
+// complete its initial list and then replays the journal of rn, releasing the
+// live events held until then, even if the replay could not run.
+func replayOnInformerSync(informers cache.Informers, obj client.Object, rn *notificationReconciler) manager.RunnableFunc {
+	return func(ctx context.Context) error {
+		defer close(rn.replayed)
+		informer, err := informers.GetInformer(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("failed to get informer for %s: %w", obj.GetObjectKind().GroupVersionKind(), err)
+		}
+		if !toolscache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+			return nil
+		}
+		if err := rn.replayJournal(ctx); err != nil {
+			rn.log.Error(err, "failed to replay journaled notifications")
+		}
+		return nil
+	}
 }
 
 // Reconciler for notifications. This is a generic reconciler that can be used for any GVK.
 type notificationReconciler struct {
-	client     client.Client
-	src        fwkdl.NotificationSource
-	extractors []fwkdl.NotificationExtractor
-	gvk        schema.GroupVersionKind
-	log        logr.Logger
+	reader      client.Reader
+	src         fwkdl.NotificationSource
+	toggles     fwkdl.ExtractorToggleSource // src, if its extractors can be disabled at runtime; nil otherwise
+	extractors  []fwkdl.NotificationExtractor
+	gvk         schema.GroupVersionKind
+	log         logr.Logger
+	getOpts     []client.GetOption     // options used when reading objects from the cache
+	copyObject  fwkdl.CopyFunc         // copies objects read from the cache; nil when the cache copies them
+	ack         fwkdl.AckFunc          // nil unless the source wants its events acknowledged
+	tracer      trace.Tracer           // nil unless the source enabled tracing
+	latency     *extractorLatency      // time spent in each extractor, by index in extractors
+	fairGraph   *extractorGraph        // extractor dependencies; nil unless the source asked for fair dispatch
+	strategy    fwkdl.DispatchStrategy // runs the extractors on each event
+	audit       fwkdl.AuditSink        // nil unless the source asked for an audit trail
+	deadline    time.Duration          // per extractor invocation; <= 0 disables it
+	maxErrors   int                    // extractor errors included in the failure log of an event
+	heartbeat   time.Duration          // interval of running extractor logs; <= 0 disables them
+	warnUnbound bool                   // warn of events while no extractor is bound, via unboundLog
+	unboundLog  logr.Logger            // sampled, to rate-limit the warnings
+	keyLocks    keyedMutex             // serializes the processing of events per object
+	inFlight    dispatchesInFlight     // events being processed, for draining sources
+	enqueued    *enqueueTracker        // delivery metadata of queued requests; nil when not bound to a controller
+	journal     fwkdl.Journal          // nil unless the source journals its events
+	replayed    chan struct{}          // closed once the journal is replayed; nil when not bound to a controller
+	watch       fwkdl.WatchOptions     // objects of the GVK watched; zero selects all
+
+	// metrics hooks, replaceable in tests.
+	clock          Clock
+	recordAge      func(age time.Duration)
+	recordDispatch func(duration time.Duration)
+	recordInFlight func(delta int)
+	recordOutcome  func(outcome fwkdl.DispatchOutcome)
+	// observeExtraction, if set, is called with each extractor invocation, by
+	// index in extractors.
+	observeExtraction func(index int, event fwkdl.NotificationEvent, err error)
+}
+
+func newNotificationReconciler(reader client.Reader, src fwkdl.NotificationSource,
+	extractors []fwkdl.NotificationExtractor, log logr.Logger) *notificationReconciler {
+	rn := &notificationReconciler{
+		reader:     reader,
+		src:        src,
+		extractors: extractors,
+		gvk:        src.GVK(),
+		log:        log,
+		clock:      defaultClock,
+		latency:    newExtractorLatency(len(extractors)),
+		maxErrors:  defaultMaxLoggedErrors,
+		strategy:   fwkdl.SequentialDispatch,
+	}
+	if custom, ok := src.(fwkdl.DispatchOptionsSource); ok {
+		opts := custom.DispatchOptions()
+		if opts.Strategy != nil {
+			rn.strategy = opts.Strategy
+		}
+		rn.deadline, rn.ack, rn.audit = opts.ExtractorDeadline, opts.Ack, opts.Audit
+	}
+	if tracing, ok := src.(fwkdl.TracingSource); ok {
+		rn.tracer = tracing.Tracer()
+	}
+	if warn, ok := src.(fwkdl.UnboundWarningSource); ok && warn.WarnWithoutExtractors() && len(extractors) == 0 {
+		rn.warnUnbound = true
+		rn.unboundLog = logging.Sampled(log, unboundWarningSampling)
+	}
+	if redelivery, ok := src.(fwkdl.RedeliverySource); ok {
+		redelivery.SetRedeliver(func(ctx context.Context, event fwkdl.NotificationEvent) error {
+			_, err := rn.dispatch(ctx, rn.log, &event)
+			return err
+		})
+	}
+	if draining, ok := src.(fwkdl.DrainableSource); ok {
+		draining.SetAwaitDispatches(rn.inFlight.await)
+	}
+	if toggles, ok := src.(fwkdl.ExtractorToggleSource); ok {
+		rn.toggles = toggles
+	}
+	if scoped, ok := src.(fwkdl.WatchOptionsSource); ok {
+		rn.watch = scoped.WatchOptions()
+	}
+	if journaling, ok := src.(fwkdl.JournalingSource); ok {
+		rn.journal = journaling.Journal()
+	}
+	if hb, ok := src.(fwkdl.HeartbeatSource); ok {
+		rn.heartbeat = hb.ExtractorHeartbeat()
+	}
+	if limited, ok := src.(fwkdl.ErrorLimitSource); ok && limited.MaxLoggedErrors() > 0 {
+		rn.maxErrors = limited.MaxLoggedErrors()
+	}
+	rn.recordAge = func(age time.Duration) {
+		metrics.RecordNotificationEventAge(rn.gvk.String(), age)
+	}
+	rn.recordDispatch = func(duration time.Duration) {
+		metrics.RecordNotificationDispatchDuration(rn.gvk.String(), duration)
+	}
+	rn.recordInFlight = func(delta int) {
+		metrics.RecordNotificationExtractorsInFlight(rn.gvk.String(), delta)
+	}
+	rn.recordOutcome = func(outcome fwkdl.DispatchOutcome) {
+		metrics.RecordNotificationDispatchOutcome(rn.gvk.String(), outcome.String())
+	}
+
+	if fair, ok := src.(fwkdl.FairDispatchSource); ok && fair.FairDispatch() && len(extractors) > 1 {
+		exts := make([]fwkdl.Extractor, len(extractors))
+		for i, ext := range extractors {
+			exts[i] = ext
+		}
+		if graph, err := newExtractorGraph(exts); err != nil {
+			log.Error(err, "fair dispatch disabled", "source", src.TypedName())
+		} else {
+			rn.fairGraph = graph
+		}
+	}
+
+	// A custom copy isolates the objects itself, so the cache's copy is skipped:
+	// the copy function is handed the cached object, and makes the only copy.
+	if copier, ok := src.(fwkdl.CopyFuncSource); ok && copier.CopyFunc() != nil {
+		log.V(logging.DEFAULT).Info("custom copy of notification objects enabled", "source", src.TypedName())
+		rn.copyObject = copier.CopyFunc()
+		rn.getOpts = append(rn.getOpts, client.UnsafeDisableDeepCopy)
+		return rn
+	}
+
+	// Skipping the deep-copy is only safe when no two consumers can observe each
+	// other's mutations, so refuse it for sources with multiple extractors.
+	if optOut, ok := src.(fwkdl.DeepCopyOptOutSource); ok && optOut.SkipDeepCopy() {
+		if len(extractors) > 1 {
+			log.Info("ignoring deep-copy opt-out for source with multiple extractors",
+				"source", src.TypedName(), "numExtractors", len(extractors))
+		} else {
+			log.V(logging.DEFAULT).Info("deep-copy of notification objects disabled", "source", src.TypedName())
+			rn.getOpts = append(rn.getOpts, client.UnsafeDisableDeepCopy)
+		}
+	}
+	return rn
 }
 
 // Reconciler carries out the actual notification logic.
 func (rn *notificationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := rn.log.WithValues("resource", req.NamespacedName, "gvk", rn.gvk.String())
+	if rn.replayed != nil {
+		select {
+		case <-rn.replayed: // replayed events are delivered before live ones
+		case <-ctx.Done():
+			return ctrl.Result{}, ctx.Err()
+		}
+	}
 
 	u := &unstructured.Unstructured{}
 	u.SetGroupVersionKind(rn.gvk)
@@ -86,8 +395,11 @@ func (rn *notificationReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		Type:   fwkdl.EventAddOrUpdate,
 		Object: u,
 	}
+	if rn.enqueued != nil {
+		event.Meta = rn.enqueued.take(req.NamespacedName)
+	}
 
-	err := rn.client.Get(ctx, req.NamespacedName, u)
+	err := rn.reader.Get(ctx, req.NamespacedName, u, rn.getOpts...)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			u.SetName(req.Name)
@@ -97,28 +409,247 @@ func (rn *notificationReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			log.Error(err, "failed to fetch resource from cache")
 			return ctrl.Result{}, err
 		}
+	} else if !rn.watch.Selects(u) {
+		// The object left the scope of the watch, e.g., its labels changed, so it is
+		// gone as far as the extractors are concerned.
+		event.Type = fwkdl.EventDelete
+		event.Object = &unstructured.Unstructured{}
+		event.Object.SetGroupVersionKind(rn.gvk)
+		event.Object.SetName(req.Name)
+		event.Object.SetNamespace(req.Namespace)
+	} else if rn.copyObject != nil {
+		event.Object = rn.copyObject(u)
 	}
 
 	return rn.dispatch(ctx, log, event)
 }
 
+// observeEventAge records the time elapsed since the last recorded change of the
+// event object, to surface informer lag. Deletions and objects without any
+// timestamp are not measured.
+func (rn *notificationReconciler) observeEventAge(event *fwkdl.NotificationEvent) {
+	if event.Type != fwkdl.EventAddOrUpdate {
+		return
+	}
+	changed, ok := lastChangeTime(event.Object)
+	if !ok {
+		return
+	}
+	rn.recordAge(max(rn.clock.Now().Sub(changed), 0)) // tolerate clock skew
+}
+
 func (rn *notificationReconciler) dispatch(ctx context.Context, log logr.Logger, event *fwkdl.NotificationEvent) (ctrl.Result, error) {
 	log.V(logging.TRACE).Info("processing notification", "eventType", event.Type)
+	if fwkdl.IsDispatching(ctx, rn.src.TypedName()) {
+		err := fmt.Errorf("source %s notified while dispatching its events: %w", rn.src.TypedName(), fwkdl.ErrReentrantNotify)
+		log.Error(err, "rejecting notification")
+		return ctrl.Result{}, err
+	}
+	// controller-runtime never reconciles the same key concurrently, but direct
+	// callers may. Objects are keyed by namespace/name, as delete events don't
+	// carry the UID.
+	// The object stays locked until extractors detached by the strategy return.
+	runs := &extractorRuns{}
+	unlock := rn.keyLocks.lock(event.Namespace() + "/" + event.Name())
+	rn.inFlight.add()
+	defer runs.releaseWhenDone(func() {
+		unlock()
+		rn.inFlight.done()
+	})
+	// Only Notify may hold the event back, not the extractors.
+	notifyCtx := ctx
+	if rn.journal != nil && !event.Meta.Replayed { // replayed events are already journaled, until the replay forgets them
+		if done, err := rn.journal.Append(*event); err != nil {
+			log.Error(err, "failed to journal notification")
+		} else {
+			// Events held back by the source, e.g., while paused, are pending until it
+			// releases them.
+			release := func() {
+				if err := done(); err != nil {
+					log.Error(err, "failed to mark journaled notification processed")
+				}
+			}
+			var held func() bool
+			notifyCtx, held = fwkdl.WithEventHold(ctx, release)
+			defer func() {
+				if !held() {
+					release()
+				}
+			}()
+		}
+	}
+	rn.observeEventAge(event)
+	if rn.warnUnbound {
+		rn.unboundLog.Info("notified of event while no extractor is bound to the source", "source", rn.src.TypedName(),
+			"gvk", rn.gvk.String(), "resource", types.NamespacedName{Namespace: event.Namespace(), Name: event.Name()})
+	}
+
+	outcome := fwkdl.DispatchSkipped
+	defer func() { rn.recordOutcome(outcome) }()
+	var record *fwkdl.AuditRecord
+	if rn.audit != nil {
+		record = &fwkdl.AuditRecord{
+			Type:   event.Type,
+			GVK:    rn.gvk,
+			Object: types.NamespacedName{Namespace: event.Namespace(), Name: event.Name()},
+		}
+		start := rn.clock.Now()
+		defer func() {
+			record.Duration = rn.clock.Now().Sub(start)
+			record.Outcome = outcome
+			rn.audit.RecordDispatch(ctx, *record)
+		}()
+	}
 
-	processed, err := rn.src.Notify(ctx, *event)
+	processed, err := rn.src.Notify(notifyCtx, *event)
 	if err != nil {
 		log.Error(err, "notifier failed to process event")
+		outcome = fwkdl.DispatchAllFailed
+		if record != nil {
+			record.NotifyErr = err
+		}
 		return ctrl.Result{}, err
 	}
 	if processed == nil {
+		if record != nil {
+			record.Dropped = true
+		}
 		return ctrl.Result{}, nil
 	}
 
-	for _, ext := range rn.extractors {
-		if err := ext.ExtractNotification(ctx, *processed); err != nil {
-			log.Error(err, "extractor failed", "extractor", ext.TypedName())
+	ctx = fwkdl.WithDispatching(ctx, rn.src.TypedName()) // flags re-entrant notifications from extractors
+	ctx = fwkdl.WithEventScratch(ctx)
+	if processed.Meta.DryRun {
+		ctx = fwkdl.WithDryRun(ctx)
+	}
+	dispatchStart := rn.clock.Now()
+	order := rn.dispatchOrder()
+	recorded := &extractorOutcomes{outcomes: make([]fwkdl.ExtractorOutcome, len(order))}
+	instrumented := make([]fwkdl.NotificationExtractor, len(order))
+	for pos, i := range order {
+		instrumented[pos] = &instrumentedExtractor{NotificationExtractor: rn.extractors[i], rn: rn, log: log, index: i, pos: pos,
+			outcomes: recorded, runs: runs}
+	}
+	errs := make([]error, len(order)) // strategies may return fewer errors than extractors
+	copy(errs, rn.strategy.Dispatch(ctx, *processed, instrumented))
+	outcomes := recorded.seal()
+	outcome = fwkdl.OutcomeOf(errs)
+
+	var failures []error
+	fields := map[string]map[string]any{} // by extractor, of the failures included in the log
+	for pos, i := range order {
+		if record != nil && outcomes[pos].Extractor != (fwkplugin.TypedName{}) {
+			record.Extractors = append(record.Extractors, outcomes[pos])
+		}
+		err := errs[pos]
+		ext := rn.extractors[i]
+		switch {
+		case err == nil:
+		case errors.Is(err, fwkdl.ErrSkip):
+			log.V(logging.TRACE).Info("extractor skipped event", "extractor", ext.TypedName(), "reason", err)
+		default:
+			failures = append(failures, fmt.Errorf("extractor %s: %w", ext.TypedName(), err))
+			if values := fwkdl.LogValues(err); values != nil && len(failures) <= rn.maxErrors {
+				fields[ext.TypedName().String()] = values
+			}
+		}
+	}
+	rn.recordDispatch(rn.clock.Now().Sub(dispatchStart))
+	if len(failures) > 0 {
+		keysAndValues := []any{"failed", len(failures), "extractors", len(rn.extractors), "outcome", outcome}
+		if len(fields) > 0 {
+			keysAndValues = append(keysAndValues, "extractorFields", fields)
 		}
+		log.Error(joinTruncated(failures, rn.maxErrors), "extractors failed", keysAndValues...)
+	} else if rn.ack != nil {
+		rn.ack(*processed, outcome)
 	}
 
 	return ctrl.Result{}, nil
 }
+
+// replayJournal dispatches the events journaled but not processed by a previous
+// run, marked as replayed.
+func (rn *notificationReconciler) replayJournal(ctx context.Context) error {
+	if rn.journal == nil {
+		return nil
+	}
+	replayed := 0
+	err := rn.journal.Replay(func(event fwkdl.NotificationEvent) error {
+		replayed++
+		event.Meta = fwkdl.EventMeta{Replayed: true}
+		_, err := rn.dispatch(ctx, rn.log, &event)
+		return err
+	})
+	if replayed > 0 {
+		rn.log.Info("replayed journaled notifications", "source", rn.src.TypedName(), "events", replayed)
+	}
+	return err
+}
+
+// joinTruncated joins up to limit errors, summarizing the rest by count, to keep
+// the log line reporting them bounded.
+func joinTruncated(errs []error, limit int) error {
+	if limit <= 0 || len(errs) <= limit {
+		return errors.Join(errs...)
+	}
+	kept := append(slices.Clone(errs[:limit]), fmt.Errorf("... and %d more", len(errs)-limit))
+	return errors.Join(kept...)
+}
+
+// dispatchOrder returns the indices of the extractors in the order they should
+// process the next event. By default this is the configured (dependency) order.
+// With fair dispatch, extractors run fastest-first by average latency, so a
+// consistently slow extractor does not delay all others, while still running
+// after the extractors it depends on.
+func (rn *notificationReconciler) dispatchOrder() []int {
+	if rn.fairGraph == nil {
+		order := make([]int, len(rn.extractors))
+		for i := range order {
+			order[i] = i
+		}
+		return order
+	}
+	order, _ := rn.fairGraph.order(rn.latency.average) // acyclic: validated in Configure
+	return order
+}
+
+// extractWithDeadline invokes the extractor with a context bounded by the source's
+// deadline, if any, and logs the extractor if it returned after the context was
+// cancelled without reporting the cancellation, i.e., it ignored the context.
+func (rn *notificationReconciler) extractWithDeadline(ctx context.Context, log logr.Logger,
+	ext fwkdl.NotificationExtractor, event fwkdl.NotificationEvent) error {
+	if rn.deadline <= 0 {
+		return rn.extract(ctx, ext, event)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rn.deadline)
+	defer cancel()
+	err := rn.extract(ctx, ext, event)
+	if ctxErr := ctx.Err(); ctxErr != nil && !errors.Is(err, ctxErr) {
+		log.Info("extractor ignored context cancellation", "extractor", ext.TypedName(),
+			"deadline", rn.deadline, "cause", context.Cause(ctx))
+	}
+	return err
+}
+
+// extract invokes the extractor, within a tracing span if the source enabled them.
+func (rn *notificationReconciler) extract(ctx context.Context, ext fwkdl.NotificationExtractor, event fwkdl.NotificationEvent) error {
+	if rn.tracer == nil {
+		return ext.ExtractNotification(ctx, event)
+	}
+
+	ctx, span := rn.tracer.Start(ctx, "datalayer.extract_notification", trace.WithAttributes(
+		attribute.String("extractor", ext.TypedName().String()),
+		attribute.String("event_type", event.Type.String()),
+		attribute.String("gvk", rn.gvk.String()),
+	))
+	defer span.End()
+
+	err := ext.ExtractNotification(ctx, event)
+	if err != nil && !errors.Is(err, fwkdl.ErrSkip) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,1349 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	fcache "k8s.io/client-go/tools/cache/testing"
+	"k8s.io/client-go/util/workqueue"
+	testclock "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
+)
+
+var podGVK = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+
+func newNotificationExtractors(n int) []*extractormocks.NotificationExtractor {
+	exts := make([]*extractormocks.NotificationExtractor, n)
+	for i := range exts {
+		exts[i] = extractormocks.NewNotificationExtractor(fmt.Sprintf("ext-%d", i))
+	}
+	return exts
+}
+
+func asNotificationExtractors(exts []*extractormocks.NotificationExtractor) []fwkdl.NotificationExtractor {
+	result := make([]fwkdl.NotificationExtractor, len(exts))
+	for i, e := range exts {
+		result[i] = e
+	}
+	return result
+}
+
+func TestNotificationReconcilerDeepCopy(t *testing.T) {
+	tests := []struct {
+		name          string
+		skipDeepCopy  bool
+		numExtractors int
+		wantUnsafe    bool
+	}{
+		{name: "deep-copy by default", skipDeepCopy: false, numExtractors: 1, wantUnsafe: false},
+		{name: "single extractor skips copy", skipDeepCopy: true, numExtractors: 1, wantUnsafe: true},
+		{name: "multiple extractors force copy", skipDeepCopy: true, numExtractors: 2, wantUnsafe: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}
+
+			var gotOpts client.GetOptions
+			c := fake.NewClientBuilder().WithObjects(pod).WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					gotOpts.ApplyOptions(opts)
+					return cl.Get(ctx, key, obj, opts...)
+				},
+			}).Build()
+
+			var opts []notifications.SourceOption
+			if tt.skipDeepCopy {
+				opts = append(opts, notifications.WithoutDeepCopy())
+			}
+			src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK, opts...)
+			exts := newNotificationExtractors(tt.numExtractors)
+
+			rn := newNotificationReconciler(c, src, asNotificationExtractors(exts), logr.Discard())
+			_, err := rn.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod"},
+			})
+			require.NoError(t, err)
+
+			unsafe := gotOpts.UnsafeDisableDeepCopy != nil && *gotOpts.UnsafeDisableDeepCopy
+			assert.Equal(t, tt.wantUnsafe, unsafe, "unexpected deep-copy behavior")
+			for _, ext := range exts {
+				require.Len(t, ext.GetEvents(), 1)
+				assert.Equal(t, "pod", ext.GetEvents()[0].Object.GetName())
+			}
+		})
+	}
+}
+
+// newInformerCache returns a started informer cache of pods serving objs, as the
+// manager's cache does, with informers listing and watching a fake source rather
+// than an API server.
+func newInformerCache(t *testing.T, objs ...runtime.Object) cache.Cache {
+	t.Helper()
+	lw := fcache.NewFakeControllerSource()
+	for _, obj := range objs {
+		lw.Add(obj)
+	}
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(podGVK, meta.RESTScopeNamespace)
+	c, err := cache.New(&rest.Config{Host: "http://127.0.0.1:0"}, cache.Options{
+		Mapper: mapper,
+		NewInformer: func(_ toolscache.ListerWatcher, obj runtime.Object, resync time.Duration,
+			indexers toolscache.Indexers) toolscache.SharedIndexInformer {
+			return toolscache.NewSharedIndexInformer(lw, obj, resync, indexers)
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = c.Start(ctx) }()
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(podGVK)
+	_, err = c.GetInformer(ctx, obj) // starts the informer of the GVK
+	require.NoError(t, err)
+	require.True(t, c.WaitForCacheSync(ctx))
+	return c
+}
+
+func TestNotificationReconcilerInformerCache(t *testing.T) {
+	pod := &unstructured.Unstructured{}
+	pod.SetGroupVersionKind(podGVK)
+	pod.SetNamespace("default")
+	pod.SetName("pod")
+	pod.SetResourceVersion("1")
+	require.NoError(t, unstructured.SetNestedField(pod.Object, "node", "spec", "nodeName"))
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod"}}
+
+	// cached returns the object held by the cache, without copying it.
+	cached := func(t *testing.T, c cache.Cache) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(podGVK)
+		require.NoError(t, c.Get(context.Background(), req.NamespacedName, u, client.UnsafeDisableDeepCopy))
+		return u
+	}
+	sameObject := func(a, b *unstructured.Unstructured) bool {
+		return reflect.ValueOf(a.Object).UnsafePointer() == reflect.ValueOf(b.Object).UnsafePointer()
+	}
+
+	tests := []struct {
+		name       string
+		opts       []notifications.SourceOption
+		wantShared bool
+	}{
+		{name: "deep-copy by default", wantShared: false},
+		{name: "opt-out shares the cached object", opts: []notifications.SourceOption{notifications.WithoutDeepCopy()}, wantShared: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newInformerCache(t, pod.DeepCopy())
+			src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK, tt.opts...)
+			ext := extractormocks.NewNotificationExtractor("ext")
+
+			rn := newNotificationReconciler(c, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+			_, err := rn.Reconcile(context.Background(), req)
+			require.NoError(t, err)
+
+			require.Len(t, ext.GetEvents(), 1)
+			got := ext.GetEvents()[0].Object
+			assert.Equal(t, "node", got.Object["spec"].(map[string]any)["nodeName"])
+			assert.Equal(t, tt.wantShared, sameObject(got, cached(t, c)), "unexpected sharing with the cache")
+		})
+	}
+
+	t.Run("custom copy is the only copy", func(t *testing.T) {
+		c := newInformerCache(t, pod.DeepCopy())
+		var copied *unstructured.Unstructured
+		copyFunc := func(u *unstructured.Unstructured) *unstructured.Unstructured {
+			copied = u
+			return u.DeepCopy()
+		}
+		src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+			notifications.WithCopyFunc(copyFunc))
+		ext := extractormocks.NewNotificationExtractor("ext")
+
+		rn := newNotificationReconciler(c, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+		_, err := rn.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+
+		require.NotNil(t, copied)
+		assert.True(t, sameObject(copied, cached(t, c)), "the copy function should be handed the cached object")
+		require.Len(t, ext.GetEvents(), 1)
+		assert.False(t, sameObject(ext.GetEvents()[0].Object, cached(t, c)), "extractors should get the copy")
+	})
+}
+
+func TestNotificationReconcilerCopyFunc(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+		Spec:       corev1.PodSpec{NodeName: "node"},
+	}
+	var fetched *unstructured.Unstructured
+	var gotOpts client.GetOptions
+	c := fake.NewClientBuilder().WithObjects(pod).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			gotOpts.ApplyOptions(opts)
+			fetched = obj.(*unstructured.Unstructured)
+			return cl.Get(ctx, key, obj, opts...)
+		},
+	}).Build()
+
+	copies := 0
+	pruneSpec := func(u *unstructured.Unstructured) *unstructured.Unstructured {
+		copies++
+		pruned := &unstructured.Unstructured{Object: map[string]any{}}
+		for k, v := range u.Object {
+			if k != "spec" {
+				pruned.Object[k] = runtime.DeepCopyJSONValue(v)
+			}
+		}
+		return pruned
+	}
+	ext := &mutatingExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("mutating")}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithCopyFunc(pruneSpec), notifications.WithoutDeepCopy())
+
+	rn := newNotificationReconciler(c, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+	_, err := rn.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, copies, "the custom copy should be used")
+	assert.True(t, gotOpts.UnsafeDisableDeepCopy != nil && *gotOpts.UnsafeDisableDeepCopy, "the cache should not copy as well")
+	require.Len(t, ext.GetEvents(), 1)
+	got := ext.GetEvents()[0].Object
+	assert.Equal(t, "pod", got.GetName())
+	assert.NotContains(t, got.Object, "spec")
+	assert.Equal(t, "true", got.GetLabels()["mutated"])
+	assert.Empty(t, fetched.GetLabels(), "the copy should be independent of the cached object")
+}
+
+func TestNotificationReconcilerDecodesOnce(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}
+	c := fake.NewClientBuilder().WithObjects(pod).Build()
+
+	decodes := 0
+	decode := notifications.DecodeAs[corev1.Pod]()
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithDecoder(func(u *unstructured.Unstructured) (runtime.Object, error) {
+			decodes++
+			return decode(u)
+		}))
+	exts := newNotificationExtractors(3)
+
+	rn := newNotificationReconciler(c, src, asNotificationExtractors(exts), logr.Discard())
+	_, err := rn.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, decodes, "object should be decoded once per event")
+	for _, ext := range exts {
+		require.Len(t, ext.GetEvents(), 1)
+		got, ok := fwkdl.TypedObject[*corev1.Pod](ext.GetEvents()[0])
+		require.True(t, ok, "extractor %s did not receive a decoded pod", ext.TypedName())
+		assert.Equal(t, "pod", got.Name)
+	}
+}
+
+func TestNotificationReconcilerSkip(t *testing.T) {
+	var errorLines []string
+	log := funcr.New(func(prefix, args string) {
+		if strings.Contains(args, `"error"=`) {
+			errorLines = append(errorLines, args)
+		}
+	}, funcr.Options{Verbosity: logging.TRACE})
+
+	skipping := extractormocks.NewNotificationExtractor("skipping").
+		WithExtractError(fmt.Errorf("not a model server pod: %w", fwkdl.ErrSkip))
+	failing := extractormocks.NewNotificationExtractor("failing").WithExtractError(errors.New("boom"))
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{skipping, failing}, log)
+	_, err := rn.dispatch(context.Background(), log, &fwkdl.NotificationEvent{
+		Type:   fwkdl.EventAddOrUpdate,
+		Object: &unstructured.Unstructured{},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, errorLines, 1, "only the real failure should be logged as an error: %v", errorLines)
+	assert.Contains(t, errorLines[0], "boom")
+	assert.Len(t, skipping.GetEvents(), 1)
+	assert.Len(t, failing.GetEvents(), 1)
+}
+
+func TestNotificationReconcilerTruncatesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []notifications.SourceOption
+		logged  int
+		omitted int
+	}{
+		{name: "default limit", logged: defaultMaxLoggedErrors, omitted: 20 - defaultMaxLoggedErrors},
+		{name: "source limit", opts: []notifications.SourceOption{notifications.WithMaxLoggedErrors(5)}, logged: 5, omitted: 15},
+		{name: "limit above failures", opts: []notifications.SourceOption{notifications.WithMaxLoggedErrors(50)}, logged: 20},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var errorLines []string
+			log := funcr.New(func(_, args string) {
+				if strings.Contains(args, `"error"=`) {
+					errorLines = append(errorLines, args)
+				}
+			}, funcr.Options{})
+
+			exts := newNotificationExtractors(20)
+			for _, ext := range exts {
+				ext.WithExtractError(errors.New("outage"))
+			}
+			src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK, tc.opts...)
+			rn := newNotificationReconciler(nil, src, asNotificationExtractors(exts), log)
+			_, err := rn.dispatch(context.Background(), log, &fwkdl.NotificationEvent{
+				Type:   fwkdl.EventAddOrUpdate,
+				Object: &unstructured.Unstructured{},
+			})
+			require.NoError(t, err)
+
+			require.Len(t, errorLines, 1, "failures should be reported in a single line")
+			line := errorLines[0]
+			assert.Contains(t, line, `"failed"=20`, "the full count should be reported")
+			assert.Equal(t, tc.logged, strings.Count(line, "outage"))
+			if tc.omitted > 0 {
+				assert.Contains(t, line, fmt.Sprintf("... and %d more", tc.omitted))
+			} else {
+				assert.NotContains(t, line, "more")
+			}
+			for _, ext := range exts[tc.logged:] {
+				assert.NotContains(t, line, ext.TypedName().String())
+			}
+		})
+	}
+}
+
+func TestNotificationReconcilerLogsExtractorFields(t *testing.T) {
+	var errorLines []string
+	log := funcr.New(func(_, args string) {
+		if strings.Contains(args, `"error"=`) {
+			errorLines = append(errorLines, args)
+		}
+	}, funcr.Options{})
+
+	exts := newNotificationExtractors(2)
+	exts[0].WithExtractError(fwkdl.WithLogValues(errors.New("bad label"), "label", "model-name"))
+	exts[1].WithExtractError(errors.New("outage"))
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+	rn := newNotificationReconciler(nil, src, asNotificationExtractors(exts), log)
+	_, err := rn.dispatch(context.Background(), log, &fwkdl.NotificationEvent{
+		Type:   fwkdl.EventAddOrUpdate,
+		Object: &unstructured.Unstructured{},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, errorLines, 1)
+	line := errorLines[0]
+	assert.Contains(t, line, fmt.Sprintf(`"extractorFields"={"%s"={"label"="model-name"}}`, exts[0].TypedName()))
+	assert.Contains(t, line, "outage", "failures without fields should still be reported")
+}
+
+func TestNotificationReconcilerUnboundWarning(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       []notifications.SourceOption
+		extractors []fwkdl.NotificationExtractor
+		want       int
+	}{
+		{name: "disabled by default"},
+		{name: "enabled", opts: []notifications.SourceOption{notifications.WithUnboundWarning()}, want: 2},
+		{
+			name:       "enabled with extractors",
+			opts:       []notifications.SourceOption{notifications.WithUnboundWarning()},
+			extractors: asNotificationExtractors(newNotificationExtractors(1)),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var warnings []string
+			log := funcr.New(func(_, args string) {
+				if strings.Contains(args, `"msg"="notified of event while no extractor is bound`) {
+					warnings = append(warnings, args)
+				}
+			}, funcr.Options{})
+
+			src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK, tc.opts...)
+			rn := newNotificationReconciler(nil, src, tc.extractors, log)
+			for range 150 {
+				_, err := rn.dispatch(context.Background(), log, &fwkdl.NotificationEvent{
+					Type:   fwkdl.EventAddOrUpdate,
+					Object: &unstructured.Unstructured{},
+				})
+				require.NoError(t, err)
+			}
+			assert.Len(t, warnings, tc.want, "warnings should be rate-limited to one per %d events", unboundWarningSampling)
+		})
+	}
+}
+
+func TestNotificationReconcilerRedelivery(t *testing.T) {
+	ext := newNotificationExtractors(1)[0]
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithPauseBuffer(10))
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+
+	src.Pause()
+	for range 3 {
+		_, err := rn.dispatch(context.Background(), logr.Discard(), &fwkdl.NotificationEvent{
+			Type:   fwkdl.EventAddOrUpdate,
+			Object: &unstructured.Unstructured{},
+		})
+		require.NoError(t, err)
+	}
+	assert.Empty(t, ext.GetEvents())
+
+	require.NoError(t, src.Resume(context.Background()))
+	assert.Len(t, ext.GetEvents(), 3, "buffered events should be redelivered through the core")
+}
+
+// mutatingExtractor labels the event object before recording the event.
+type mutatingExtractor struct {
+	*extractormocks.NotificationExtractor
+}
+
+func (m *mutatingExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	event.Object.SetLabels(map[string]string{"mutated": "true"})
+	return m.NotificationExtractor.ExtractNotification(ctx, event)
+}
+
+func TestNotificationReconcilerForwarding(t *testing.T) {
+	extA := newNotificationExtractors(1)[0]
+	mutating := &mutatingExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("mutating")}
+	srcA := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "a", podGVK)
+	srcB := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "b", podGVK)
+	require.NoError(t, srcB.ForwardFrom(srcA))
+
+	rnA := newNotificationReconciler(nil, srcA, []fwkdl.NotificationExtractor{extA}, logr.Discard())
+	_ = newNotificationReconciler(nil, srcB, []fwkdl.NotificationExtractor{mutating}, logr.Discard())
+
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("default")
+	obj.SetName("pod")
+	_, err := rnA.dispatch(context.Background(), logr.Discard(), &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+	require.NoError(t, err)
+
+	require.Len(t, mutating.GetEvents(), 1, "events of a should reach the extractors of b")
+	assert.Equal(t, "pod", mutating.GetEvents()[0].Name())
+	require.Len(t, extA.GetEvents(), 1)
+	assert.Empty(t, extA.GetEvents()[0].Object.GetLabels(), "forwarded events should carry a copy of the object")
+}
+
+func TestNotificationReconcilerTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	ok := extractormocks.NewNotificationExtractor("ok")
+	failing := extractormocks.NewNotificationExtractor("failing").WithExtractError(errors.New("boom"))
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithTracer(tracer))
+
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ok, failing}, logr.Discard())
+	_, err := rn.dispatch(context.Background(), logr.Discard(), &fwkdl.NotificationEvent{
+		Type:   fwkdl.EventDelete,
+		Object: &unstructured.Unstructured{},
+	})
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2, "expected a span per extractor")
+	for i, ext := range []fwkdl.NotificationExtractor{ok, failing} {
+		span := spans[i]
+		assert.Equal(t, "datalayer.extract_notification", span.Name())
+		assert.Contains(t, span.Attributes(), attribute.String("extractor", ext.TypedName().String()))
+		assert.Contains(t, span.Attributes(), attribute.String("event_type", "delete"))
+	}
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	require.Len(t, spans[1].Events(), 1, "expected the error to be recorded on the span")
+	assert.Equal(t, "exception", spans[1].Events()[0].Name)
+}
+
+func TestNotificationReconcilerNoTracer(t *testing.T) {
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithTracer(nil))
+	ext := extractormocks.NewNotificationExtractor("ext")
+
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+	assert.Nil(t, rn.tracer)
+	_, err := rn.dispatch(context.Background(), logr.Discard(), &fwkdl.NotificationEvent{
+		Type:   fwkdl.EventAddOrUpdate,
+		Object: &unstructured.Unstructured{},
+	})
+	require.NoError(t, err)
+	assert.Len(t, ext.GetEvents(), 1)
+}
+
+// blockingExtractor runs until its context is done, or for a fixed time if it
+// ignores the context.
+type blockingExtractor struct {
+	*extractormocks.NotificationExtractor
+	ignoreCtx bool
+}
+
+func (b *blockingExtractor) ExtractNotification(ctx context.Context, _ fwkdl.NotificationEvent) error {
+	if b.ignoreCtx {
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestNotificationReconcilerExtractorDeadline(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		ignoreCtx bool
+		wantWarn  bool
+	}{
+		{name: "extractor honoring cancellation", ignoreCtx: false, wantWarn: false},
+		{name: "extractor ignoring cancellation", ignoreCtx: true, wantWarn: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var warnings []string
+			log := funcr.New(func(_, args string) {
+				if strings.Contains(args, "ignored context cancellation") {
+					warnings = append(warnings, args)
+				}
+			}, funcr.Options{})
+
+			ext := &blockingExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("blocking"), ignoreCtx: tt.ignoreCtx}
+			src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+				notifications.WithExtractorDeadline(5*time.Millisecond))
+
+			rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, log)
+			_, err := rn.dispatch(context.Background(), log, &fwkdl.NotificationEvent{
+				Type:   fwkdl.EventAddOrUpdate,
+				Object: &unstructured.Unstructured{},
+			})
+			require.NoError(t, err)
+
+			if tt.wantWarn {
+				require.Len(t, warnings, 1)
+				assert.Contains(t, warnings[0], ext.TypedName().String())
+			} else {
+				assert.Empty(t, warnings)
+			}
+		})
+	}
+}
+
+// reentrantExtractor notifies its own source while processing an event.
+type reentrantExtractor struct {
+	*extractormocks.NotificationExtractor
+	rn          *notificationReconciler
+	dispatchErr error
+}
+
+func (r *reentrantExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	_, r.dispatchErr = r.rn.dispatch(ctx, logr.Discard(), &event)
+	return r.NotificationExtractor.ExtractNotification(ctx, event)
+}
+
+func TestNotificationReconcilerReentrancy(t *testing.T) {
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+	other := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "other", podGVK)
+	ext := &reentrantExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("reentrant")}
+	ext.rn = newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+
+	event := &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}}
+	_, err := ext.rn.dispatch(context.Background(), logr.Discard(), event)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, ext.dispatchErr, fwkdl.ErrReentrantNotify)
+	assert.Len(t, ext.GetEvents(), 1, "nested notifications should not be dispatched")
+
+	// other sources can be notified from an extractor.
+	otherExt := extractormocks.NewNotificationExtractor("other")
+	otherRn := newNotificationReconciler(nil, other, []fwkdl.NotificationExtractor{otherExt}, logr.Discard())
+	ctx := fwkdl.WithDispatching(context.Background(), src.TypedName())
+	_, err = otherRn.dispatch(ctx, logr.Discard(), event)
+	assert.NoError(t, err)
+	assert.Len(t, otherExt.GetEvents(), 1)
+}
+
+type recordingAuditSink struct {
+	records []fwkdl.AuditRecord
+}
+
+func (s *recordingAuditSink) RecordDispatch(_ context.Context, record fwkdl.AuditRecord) {
+	s.records = append(s.records, record)
+}
+
+func TestNotificationReconcilerAudit(t *testing.T) {
+	clk := testclock.NewFakeClock(time.Now())
+	skipErr := fmt.Errorf("not interested: %w", fwkdl.ErrSkip)
+	failErr := errors.New("boom")
+	exts := []fwkdl.NotificationExtractor{
+		newSlowExtractor(clk, 10*time.Millisecond, "ok"),
+		extractormocks.NewNotificationExtractor("skipping").WithExtractError(skipErr),
+		extractormocks.NewNotificationExtractor("failing").WithExtractError(failErr),
+	}
+	sink := &recordingAuditSink{}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithAuditSink(sink))
+
+	rn := newNotificationReconciler(nil, src, exts, logr.Discard())
+	rn.clock = clk
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("default")
+	obj.SetName("pod")
+	for _, eventType := range []fwkdl.EventType{fwkdl.EventAddOrUpdate, fwkdl.EventDelete} {
+		_, err := rn.dispatch(context.Background(), logr.Discard(), &fwkdl.NotificationEvent{Type: eventType, Object: obj})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, sink.records, 2, "expected one audit record per event")
+	for i, eventType := range []fwkdl.EventType{fwkdl.EventAddOrUpdate, fwkdl.EventDelete} {
+		record := sink.records[i]
+		assert.Equal(t, eventType, record.Type)
+		assert.Equal(t, podGVK, record.GVK)
+		assert.Equal(t, types.NamespacedName{Namespace: "default", Name: "pod"}, record.Object)
+		assert.NoError(t, record.NotifyErr)
+		assert.False(t, record.Dropped)
+		assert.Equal(t, 10*time.Millisecond, record.Duration)
+
+		require.Len(t, record.Extractors, 3)
+		assert.Equal(t, exts[0].TypedName(), record.Extractors[0].Extractor)
+		assert.NoError(t, record.Extractors[0].Err)
+		assert.Equal(t, 10*time.Millisecond, record.Extractors[0].Duration)
+		assert.ErrorIs(t, record.Extractors[1].Err, fwkdl.ErrSkip)
+		assert.ErrorIs(t, record.Extractors[2].Err, failErr)
+	}
+}
+
+func TestNotificationReconcilerNoAuditSink(t *testing.T) {
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+	rn := newNotificationReconciler(nil, src, asNotificationExtractors(newNotificationExtractors(1)), logr.Discard())
+	assert.Nil(t, rn.audit)
+}
+
+// slowExtractor advances a fake clock on every notification, simulating work.
+type slowExtractor struct {
+	*dependentExtractor
+	clk   *testclock.FakeClock
+	delay time.Duration
+}
+
+func newSlowExtractor(clk *testclock.FakeClock, delay time.Duration, name string, deps ...string) *slowExtractor {
+	return &slowExtractor{dependentExtractor: newDependentExtractor(name, deps...), clk: clk, delay: delay}
+}
+
+func (s *slowExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	s.clk.Step(s.delay)
+	return s.dependentExtractor.ExtractNotification(ctx, event)
+}
+
+func TestNotificationReconcilerFairDispatch(t *testing.T) {
+	event := &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}}
+	dispatchTwice := func(t *testing.T, rn *notificationReconciler) {
+		for range 2 {
+			_, err := rn.dispatch(context.Background(), logr.Discard(), event)
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("latency is accounted per extractor", func(t *testing.T) {
+		clk := testclock.NewFakeClock(time.Now())
+		exts := []fwkdl.NotificationExtractor{
+			newSlowExtractor(clk, 100*time.Millisecond, "slow"),
+			newSlowExtractor(clk, 10*time.Millisecond, "fast"),
+		}
+		src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+
+		rn := newNotificationReconciler(nil, src, exts, logr.Discard())
+		rn.clock = clk
+		dispatchTwice(t, rn)
+
+		assert.Equal(t, 200*time.Millisecond, rn.latency.cumulative(0))
+		assert.Equal(t, 20*time.Millisecond, rn.latency.cumulative(1))
+		assert.Equal(t, []int{0, 1}, rn.dispatchOrder(), "configured order is kept without fair dispatch")
+	})
+
+	t.Run("fast extractors run first", func(t *testing.T) {
+		clk := testclock.NewFakeClock(time.Now())
+		exts := []fwkdl.NotificationExtractor{
+			newSlowExtractor(clk, 100*time.Millisecond, "slow"),
+			newSlowExtractor(clk, 10*time.Millisecond, "fast"),
+		}
+		src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+			notifications.WithFairDispatch())
+
+		rn := newNotificationReconciler(nil, src, exts, logr.Discard())
+		rn.clock = clk
+		dispatchTwice(t, rn)
+
+		assert.Equal(t, []int{1, 0}, rn.dispatchOrder())
+	})
+
+	t.Run("dependencies are respected", func(t *testing.T) {
+		clk := testclock.NewFakeClock(time.Now())
+		exts := []fwkdl.NotificationExtractor{
+			newSlowExtractor(clk, 100*time.Millisecond, "slow"),
+			newSlowExtractor(clk, 10*time.Millisecond, "fast", "slow"),
+			newSlowExtractor(clk, 50*time.Millisecond, "medium"),
+		}
+		src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+			notifications.WithFairDispatch())
+
+		rn := newNotificationReconciler(nil, src, exts, logr.Discard())
+		rn.clock = clk
+		dispatchTwice(t, rn)
+
+		assert.Equal(t, []int{2, 0, 1}, rn.dispatchOrder())
+	})
+}
+
+// firstOnlyStrategy records its invocations and only runs the first extractor.
+type firstOnlyStrategy struct {
+	calls [][]fwkplugin.TypedName
+}
+
+func (s *firstOnlyStrategy) Dispatch(ctx context.Context, event fwkdl.NotificationEvent, extractors []fwkdl.NotificationExtractor) []error {
+	names := make([]fwkplugin.TypedName, len(extractors))
+	for i, ext := range extractors {
+		names[i] = ext.TypedName()
+	}
+	s.calls = append(s.calls, names)
+	return []error{extractors[0].ExtractNotification(ctx, event)}
+}
+
+func TestNotificationReconcilerDispatchStrategy(t *testing.T) {
+	t.Run("sequential by default", func(t *testing.T) {
+		src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+		rn := newNotificationReconciler(nil, src, asNotificationExtractors(newNotificationExtractors(1)), logr.Discard())
+		assert.Equal(t, fwkdl.SequentialDispatch, rn.strategy)
+	})
+
+	t.Run("custom strategy runs the extractors", func(t *testing.T) {
+		failErr := errors.New("boom")
+		exts := []*extractormocks.NotificationExtractor{
+			extractormocks.NewNotificationExtractor("first").WithExtractError(failErr),
+			extractormocks.NewNotificationExtractor("second"),
+		}
+		strategy := &firstOnlyStrategy{}
+		sink := &recordingAuditSink{}
+		src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+			notifications.WithDispatchStrategy(strategy), notifications.WithAuditSink(sink))
+
+		rn := newNotificationReconciler(nil, src, asNotificationExtractors(exts), logr.Discard())
+		_, err := rn.dispatch(context.Background(), logr.Discard(),
+			&fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}})
+		require.NoError(t, err)
+
+		require.Len(t, strategy.calls, 1)
+		assert.Equal(t, []fwkplugin.TypedName{exts[0].TypedName(), exts[1].TypedName()}, strategy.calls[0])
+		assert.Len(t, exts[0].GetEvents(), 1)
+		assert.Empty(t, exts[1].GetEvents(), "extractors not run by the strategy should not be invoked")
+		require.Len(t, sink.records, 1)
+		require.Len(t, sink.records[0].Extractors, 1, "only invoked extractors should be audited")
+		assert.ErrorIs(t, sink.records[0].Extractors[0].Err, failErr)
+	})
+}
+
+// gatedExtractor signals when it starts and returns once released.
+type gatedExtractor struct {
+	fwkdl.NotificationExtractor
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *gatedExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	close(b.started)
+	<-b.release
+	return b.NotificationExtractor.ExtractNotification(ctx, event)
+}
+
+func TestNotificationReconcilerInFlight(t *testing.T) {
+	ext := &gatedExtractor{
+		NotificationExtractor: extractormocks.NewNotificationExtractor("gated"),
+		started:               make(chan struct{}),
+		release:               make(chan struct{}),
+	}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+	var inFlight atomic.Int64
+	rn.recordInFlight = func(delta int) { inFlight.Add(int64(delta)) }
+
+	done := make(chan error)
+	go func() {
+		_, err := rn.dispatch(context.Background(), logr.Discard(),
+			&fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}})
+		done <- err
+	}()
+
+	<-ext.started
+	assert.Equal(t, int64(1), inFlight.Load(), "the blocked invocation should be in flight")
+	close(ext.release)
+	require.NoError(t, <-done)
+	assert.Equal(t, int64(0), inFlight.Load(), "the invocation should no longer be in flight once returned")
+}
+
+func TestNotificationReconcilerDisabledExtractor(t *testing.T) {
+	extractors := newNotificationExtractors(2)
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+	rn := newNotificationReconciler(nil, src, asNotificationExtractors(extractors), logr.Discard())
+	dispatch := func() {
+		_, err := rn.dispatch(context.Background(), logr.Discard(),
+			&fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}})
+		require.NoError(t, err, "a disabled extractor should not fail the event")
+	}
+
+	disabled := extractors[0].TypedName().Name
+	src.DisableExtractor(disabled)
+	dispatch()
+	dispatch()
+	assert.Empty(t, extractors[0].GetEvents(), "a disabled extractor should not be invoked")
+	assert.Len(t, extractors[1].GetEvents(), 2)
+	assert.Equal(t, map[string]uint64{disabled: 2}, src.DisabledSkips())
+
+	src.EnableExtractor(disabled)
+	dispatch()
+	assert.Len(t, extractors[0].GetEvents(), 1, "a re-enabled extractor should be invoked again")
+	assert.Len(t, extractors[1].GetEvents(), 3)
+}
+
+func TestNotificationReconcilerWatchOptions(t *testing.T) {
+	selected := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "selected", Namespace: "default", Labels: map[string]string{"app": "vllm"}}}
+	relabeled := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "relabeled", Namespace: "default"}}
+	other := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other", Labels: map[string]string{"app": "vllm"}}}
+	c := fake.NewClientBuilder().WithObjects(selected, relabeled, other).Build()
+	ext := extractormocks.NewNotificationExtractor("ext")
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithWatchOptions(fwkdl.WatchOptions{
+			Namespaces:    []string{"default"},
+			LabelSelector: labels.SelectorFromSet(labels.Set{"app": "vllm"}),
+		}))
+	rn := newNotificationReconciler(c, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+
+	pred := watchPredicate(rn.watch)
+	assert.True(t, pred.Create(event.CreateEvent{Object: selected}))
+	assert.False(t, pred.Create(event.CreateEvent{Object: other}), "objects out of the namespaces should not be watched")
+	assert.False(t, pred.Create(event.CreateEvent{Object: relabeled}), "objects not matching the selector should not be watched")
+	assert.True(t, pred.Update(event.UpdateEvent{ObjectOld: selected, ObjectNew: relabeled}),
+		"objects leaving the scope should be reconciled")
+
+	for _, pod := range []*corev1.Pod{selected, relabeled} {
+		_, err := rn.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}})
+		require.NoError(t, err)
+	}
+	events := ext.GetEvents()
+	require.Len(t, events, 2)
+	assert.Equal(t, fwkdl.EventAddOrUpdate, events[0].Type)
+	assert.Equal(t, "selected", events[0].Name())
+	assert.Equal(t, fwkdl.EventDelete, events[1].Type, "an object out of scope should be dispatched as deleted")
+	assert.Equal(t, "relabeled", events[1].Name())
+}
+
+func TestNotificationReconcilerDryRun(t *testing.T) {
+	ext := &dryRunRecordingExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("ext")}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithDryRun())
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+
+	_, err := rn.dispatch(context.Background(), logr.Discard(),
+		&fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true}, ext.dryRuns, "extractors of a dry-run source should see the marker")
+}
+
+func TestNotificationReconcilerAck(t *testing.T) {
+	tests := []struct {
+		name        string
+		errs        []error
+		wantAck     bool
+		wantOutcome fwkdl.DispatchOutcome
+	}{
+		{"all succeed", []error{nil, nil}, true, fwkdl.DispatchSuccess},
+		{"skipped", []error{nil, fmt.Errorf("not relevant: %w", fwkdl.ErrSkip)}, true, fwkdl.DispatchSuccess},
+		{"all skipped", []error{fwkdl.ErrSkip, fwkdl.ErrSkip}, true, fwkdl.DispatchSkipped},
+		{"one fails", []error{nil, errors.New("extraction failed")}, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extractors := make([]fwkdl.NotificationExtractor, len(tt.errs))
+			for i, err := range tt.errs {
+				extractors[i] = extractormocks.NewNotificationExtractor(fmt.Sprintf("ext-%d", i)).WithExtractError(err)
+			}
+			var acked []fwkdl.NotificationEvent
+			var outcomes []fwkdl.DispatchOutcome
+			src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+				notifications.WithAckFunc(func(event fwkdl.NotificationEvent, outcome fwkdl.DispatchOutcome) {
+					acked = append(acked, event)
+					outcomes = append(outcomes, outcome)
+				}))
+			rn := newNotificationReconciler(nil, src, extractors, logr.Discard())
+
+			obj := &unstructured.Unstructured{}
+			obj.SetName("pod")
+			_, err := rn.dispatch(context.Background(), logr.Discard(), &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+			require.NoError(t, err)
+			if tt.wantAck {
+				require.Len(t, acked, 1)
+				assert.Equal(t, "pod", acked[0].Name())
+				assert.Equal(t, []fwkdl.DispatchOutcome{tt.wantOutcome}, outcomes)
+			} else {
+				assert.Empty(t, acked, "events failed by an extractor should not be acknowledged")
+			}
+		})
+	}
+}
+
+func TestNotificationReconcilerNilObject(t *testing.T) {
+	var lines []string
+	ctx := logr.NewContext(context.Background(), funcr.New(func(_, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{}))
+	ext := extractormocks.NewNotificationExtractor("ext")
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+
+	_, err := rn.dispatch(ctx, logr.Discard(), &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate})
+	require.NoError(t, err)
+	assert.Empty(t, ext.GetEvents(), "extractors should not be handed events without an object")
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], "dropping notification without an object")
+	assert.Equal(t, map[notifications.SkipReason]uint64{notifications.SkipNilObject: 1}, src.SkipStats())
+}
+
+// scratchExtractor runs fn on the scratch of each event before recording it.
+type scratchExtractor struct {
+	*extractormocks.NotificationExtractor
+	fn func(scratch *fwkdl.EventScratch)
+}
+
+func (e *scratchExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	e.fn(fwkdl.EventScratchFrom(ctx))
+	return e.NotificationExtractor.ExtractNotification(ctx, event)
+}
+
+func TestNotificationReconcilerEventScratch(t *testing.T) {
+	scores := 0
+	writer := &scratchExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("writer"),
+		fn: func(scratch *fwkdl.EventScratch) {
+			scores++
+			scratch.Store("writer/score", scores)
+		}}
+	var read []any
+	reader := &scratchExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("reader"),
+		fn: func(scratch *fwkdl.EventScratch) {
+			value, _ := scratch.Load("writer/score")
+			read = append(read, value)
+		}}
+	var stale []bool
+	first := &scratchExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("first"),
+		fn: func(scratch *fwkdl.EventScratch) {
+			_, ok := scratch.Load("writer/score")
+			stale = append(stale, ok)
+		}}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{first, writer, reader}, logr.Discard())
+
+	for range 2 {
+		_, err := rn.dispatch(context.Background(), logr.Discard(),
+			&fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []any{1, 2}, read, "later extractors should read the values of earlier ones")
+	assert.Equal(t, []bool{false, false}, stale, "values should not outlive the dispatch of their event")
+}
+
+func TestNotificationReconcilerDispatchOutcome(t *testing.T) {
+	failing := func(name string) fwkdl.NotificationExtractor {
+		return extractormocks.NewNotificationExtractor(name).WithExtractError(errors.New("extraction failed"))
+	}
+	skipping := func(name string) fwkdl.NotificationExtractor {
+		return extractormocks.NewNotificationExtractor(name).WithExtractError(fmt.Errorf("not relevant: %w", fwkdl.ErrSkip))
+	}
+	succeeding := func(name string) fwkdl.NotificationExtractor {
+		return extractormocks.NewNotificationExtractor(name)
+	}
+	tests := []struct {
+		name       string
+		opts       []notifications.SourceOption
+		extractors []fwkdl.NotificationExtractor
+		want       fwkdl.DispatchOutcome
+	}{
+		{"success", nil, []fwkdl.NotificationExtractor{succeeding("a"), skipping("b")}, fwkdl.DispatchSuccess},
+		{"partial failure", nil, []fwkdl.NotificationExtractor{succeeding("a"), failing("b")}, fwkdl.DispatchPartialFailure},
+		{"all failed", nil, []fwkdl.NotificationExtractor{failing("a"), failing("b")}, fwkdl.DispatchAllFailed},
+		{"all skipped", nil, []fwkdl.NotificationExtractor{skipping("a")}, fwkdl.DispatchSkipped},
+		{"dropped", []notifications.SourceOption{notifications.WithEventFilter(func(fwkdl.NotificationEvent) bool { return false })},
+			[]fwkdl.NotificationExtractor{succeeding("a")}, fwkdl.DispatchSkipped},
+		{"timed out", []notifications.SourceOption{notifications.WithExtractorDeadline(time.Millisecond)},
+			[]fwkdl.NotificationExtractor{succeeding("a"), &blockingExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("b")}},
+			fwkdl.DispatchTimedOut},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &recordingAuditSink{}
+			opts := append([]notifications.SourceOption{notifications.WithAuditSink(sink)}, tt.opts...)
+			src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK, opts...)
+			rn := newNotificationReconciler(nil, src, tt.extractors, logr.Discard())
+			var recorded []fwkdl.DispatchOutcome
+			rn.recordOutcome = func(outcome fwkdl.DispatchOutcome) { recorded = append(recorded, outcome) }
+
+			_, err := rn.dispatch(context.Background(), logr.Discard(),
+				&fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}})
+			require.NoError(t, err)
+			assert.Equal(t, []fwkdl.DispatchOutcome{tt.want}, recorded, "the outcome should be passed to the metrics hook")
+			require.Len(t, sink.records, 1)
+			assert.Equal(t, tt.want, sink.records[0].Outcome)
+		})
+	}
+}
+
+func TestNotificationReconcilerJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	newPodEvent := func(name string) fwkdl.NotificationEvent {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace("default")
+		obj.SetName(name)
+		return fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj}
+	}
+
+	// interrupted run: events journaled, but not processed
+	journal, err := notifications.NewFileJournal(path)
+	require.NoError(t, err)
+	for _, name := range []string{"pod-1", "pod-2"} {
+		_, err := journal.Append(newPodEvent(name))
+		require.NoError(t, err)
+	}
+	require.NoError(t, journal.Close())
+
+	// restart
+	journal, err = notifications.NewFileJournal(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = journal.Close() })
+	ext := extractormocks.NewNotificationExtractor("ext")
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithJournal(journal))
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+	require.NoError(t, rn.replayJournal(context.Background()))
+
+	live := newPodEvent("pod-3")
+	_, err = rn.dispatch(context.Background(), logr.Discard(), &live)
+	require.NoError(t, err)
+
+	events := ext.GetEvents()
+	require.Len(t, events, 3)
+	for i, name := range []string{"pod-1", "pod-2", "pod-3"} {
+		assert.Equal(t, name, events[i].Name(), "replayed events should be delivered first, in order")
+		assert.Equal(t, i < 2, events[i].Meta.Replayed)
+	}
+
+	require.NoError(t, rn.replayJournal(context.Background()))
+	assert.Len(t, ext.GetEvents(), 3, "processed events should not be replayed again")
+}
+
+func TestNotificationReconcilerJournalHeldEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	journal, err := notifications.NewFileJournal(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = journal.Close() })
+	ext := extractormocks.NewNotificationExtractor("ext")
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithJournal(journal), notifications.WithPauseBuffer(1))
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+	// pending returns the names of the events a restart would replay.
+	pending := func() []string {
+		var names []string
+		_ = journal.Replay(func(event fwkdl.NotificationEvent) error {
+			names = append(names, event.Name())
+			return errors.New("keep the event")
+		})
+		return names
+	}
+	notify := func(name string) {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace("default")
+		obj.SetName(name)
+		_, err := rn.dispatch(context.Background(), logr.Discard(), &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+		require.NoError(t, err)
+	}
+
+	src.Pause()
+	notify("evicted")
+	assert.Equal(t, []string{"evicted"}, pending(), "held events should stay journaled")
+	notify("held")
+	assert.Equal(t, []string{"held"}, pending(), "events evicted from the buffer are dropped")
+
+	require.NoError(t, src.Resume(context.Background()))
+	require.Len(t, ext.GetEvents(), 1)
+	assert.Equal(t, "held", ext.GetEvents()[0].Name())
+	assert.Empty(t, pending(), "redelivered events should be processed")
+}
+
+func TestReplayOnInformerSync(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	journal, err := notifications.NewFileJournal(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = journal.Close() })
+	interrupted := &unstructured.Unstructured{}
+	interrupted.SetNamespace("default")
+	interrupted.SetName("interrupted")
+	_, err = journal.Append(fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: interrupted})
+	require.NoError(t, err)
+
+	pod := &unstructured.Unstructured{}
+	pod.SetGroupVersionKind(podGVK)
+	pod.SetNamespace("default")
+	pod.SetName("live")
+	pod.SetResourceVersion("1")
+	ext := extractormocks.NewNotificationExtractor("ext")
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithJournal(journal))
+	informers := newInformerCache(t, pod)
+	rn := newNotificationReconciler(informers, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+	rn.replayed = make(chan struct{})
+
+	reconciled := make(chan error, 1)
+	go func() {
+		_, err := rn.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "live"}})
+		reconciled <- err
+	}()
+	assert.Never(t, func() bool { return len(ext.GetEvents()) > 0 }, 50*time.Millisecond, time.Millisecond,
+		"live events should wait for the replay")
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(podGVK)
+	require.NoError(t, replayOnInformerSync(informers, obj, rn)(context.Background()))
+	require.NoError(t, <-reconciled)
+	events := ext.GetEvents()
+	require.Len(t, events, 2)
+	assert.Equal(t, "interrupted", events[0].Name())
+	assert.True(t, events[0].Meta.Replayed)
+	assert.Equal(t, "live", events[1].Name())
+}
+
+func TestWatchCacheOptions(t *testing.T) {
+	_, scoped := watchCacheOptions(fwkdl.WatchOptions{})
+	assert.False(t, scoped, "zero options should use the manager's cache")
+	_, scoped = watchCacheOptions(fwkdl.WatchOptions{LabelSelector: labels.Everything(), Filter: func(fwkdl.NotificationEvent) bool { return true }})
+	assert.False(t, scoped, "selecting everything, or filtering events, should use the manager's cache")
+
+	// The cache of a scoped watch only lists and watches the selected objects.
+	var mu sync.Mutex
+	requested := map[string]string{} // label selector, by path
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requested[r.URL.Path] = r.URL.Query().Get("labelSelector")
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("sendInitialEvents") == "true" { // fall back from streaming lists
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("watch") == "true" {
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		_, _ = w.Write([]byte(`{"kind":"PodList","apiVersion":"v1","metadata":{"resourceVersion":"1"},"items":[]}`))
+	}))
+	defer server.Close()
+
+	opts, scoped := watchCacheOptions(fwkdl.WatchOptions{
+		Namespaces:    []string{"a", "b"},
+		LabelSelector: labels.SelectorFromSet(labels.Set{"app": "web"}),
+	})
+	require.True(t, scoped)
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(podGVK, meta.RESTScopeNamespace)
+	opts.Mapper = mapper
+	c, err := cache.New(&rest.Config{Host: server.URL}, opts)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	go func() { _ = c.Start(ctx) }()
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(podGVK)
+	_, err = c.GetInformer(ctx, obj)
+	require.NoError(t, err)
+	require.True(t, c.WaitForCacheSync(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[string]string{
+		"/api/v1/namespaces/a/pods": "app=web",
+		"/api/v1/namespaces/b/pods": "app=web",
+	}, requested)
+}
+
+func TestResyncSource(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(podGVK)
+	informer := &controllertest.FakeInformer{Synced: true}
+	informers := &informertest.FakeInformers{
+		InformersByGVK: map[schema.GroupVersionKind]toolscache.SharedIndexInformer{podGVK: informer},
+	}
+	watch := fwkdl.WatchOptions{Namespaces: []string{"default"}}
+	h := &metaRecordingHandler{tracker: newEnqueueTracker(testclock.NewFakeClock(time.Now()))}
+	q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer q.ShutDown()
+	require.NoError(t, resyncSource(informers, obj, time.Minute, h, watch).Start(context.Background(), q))
+
+	pod := func(namespace, name, version string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, ResourceVersion: version}}
+	}
+	informer.Update(pod("default", "changed", "1"), pod("default", "changed", "2"))
+	informer.Update(pod("other", "unwatched", "1"), pod("other", "unwatched", "1"))
+	informer.Update(pod("default", "resynced", "1"), pod("default", "resynced", "1"))
+
+	require.Equal(t, 1, q.Len(), "only resyncs of watched objects should be enqueued")
+	req, _ := q.Get()
+	assert.Equal(t, types.NamespacedName{Namespace: "default", Name: "resynced"}, req.NamespacedName)
+}
+
+// builderIndexer registers indexes with a fake client under construction.
+type builderIndexer struct {
+	b *fake.ClientBuilder
+}
+
+func (bi builderIndexer) IndexField(_ context.Context, obj client.Object, field string, fn client.IndexerFunc) error {
+	bi.b.WithIndex(obj, field, fn)
+	return nil
+}
+
+func TestNotificationSourceIndexes(t *testing.T) {
+	byApp := func(obj *unstructured.Unstructured) []string {
+		if app, ok := obj.GetLabels()["app"]; ok {
+			return []string{app}
+		}
+		return nil
+	}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithIndex("app", byApp))
+	_, err := src.Lookup(context.Background(), "app", "web")
+	require.ErrorContains(t, err, "not bound")
+
+	pod := func(name, app string) *corev1.Pod {
+		p := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}}
+		if app != "" {
+			p.Labels = map[string]string{"app": app}
+		}
+		return p
+	}
+	b := fake.NewClientBuilder().WithObjects(pod("web-1", "web"), pod("web-2", "web"), pod("db-1", "db"), pod("bare", ""))
+	obj := &corev1.Pod{}
+	require.NoError(t, registerIndexes(context.Background(), builderIndexer{b: b}, obj, src.TypedName().Name, src.Indexes()))
+	src.SetLookup(newIndexLookup(b.Build(), podGVK, src.TypedName().Name))
+
+	names := func(objs []*unstructured.Unstructured) []string {
+		result := make([]string, 0, len(objs))
+		for _, o := range objs {
+			result = append(result, o.GetName())
+		}
+		return result
+	}
+	web, err := src.Lookup(context.Background(), "app", "web")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"web-1", "web-2"}, names(web))
+	db, err := src.Lookup(context.Background(), "app", "db")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"db-1"}, names(db))
+	none, err := src.Lookup(context.Background(), "app", "cache")
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	_, err = src.Lookup(context.Background(), "tier", "web")
+	assert.ErrorContains(t, err, "unknown index")
+}
+
+func TestMarkSyncedOnInformerSync(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(podGVK)
+
+	newInformers := func(synced bool) *informertest.FakeInformers {
+		return &informertest.FakeInformers{
+			InformersByGVK: map[schema.GroupVersionKind]toolscache.SharedIndexInformer{
+				podGVK: &controllertest.FakeInformer{Synced: synced},
+			},
+		}
+	}
+
+	t.Run("synced informer marks source", func(t *testing.T) {
+		src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+		require.False(t, src.HasSynced())
+
+		run := markSyncedOnInformerSync(newInformers(true), obj, src)
+		require.NoError(t, run(context.Background()))
+		assert.True(t, src.HasSynced())
+	})
+
+	t.Run("cancelled before sync", func(t *testing.T) {
+		src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		run := markSyncedOnInformerSync(newInformers(false), obj, src)
+		require.NoError(t, run(ctx))
+		assert.False(t, src.HasSynced())
+	})
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"sync"
+)
+
+// keyedMutex serializes work per key: calls for the same key never overlap, while
+// calls for different keys run in parallel. Each key is locked individually, so a
+// held key holds up no other, and keys are forgotten once no caller holds or
+// waits for them. The zero value is ready to use.
+type keyedMutex struct {
+	mu   sync.Mutex
+	keys map[string]*keyLock
+}
+
+// keyLock is the mutex of a key, with the number of callers holding or waiting
+// for it.
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock acquires the mutex of key and returns the function releasing it.
+func (m *keyedMutex) lock(key string) func() {
+	m.mu.Lock()
+	if m.keys == nil {
+		m.keys = make(map[string]*keyLock)
+	}
+	l, ok := m.keys[key]
+	if !ok {
+		l = &keyLock{}
+		m.keys[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if l.refs--; l.refs == 0 {
+			delete(m.keys, key)
+		}
+	}
+}
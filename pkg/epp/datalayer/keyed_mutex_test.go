@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
+)
+
+// concurrencyExtractor tracks how many events are processed concurrently, per
+// object and overall.
+type concurrencyExtractor struct {
+	*extractormocks.NotificationExtractor
+	mu          sync.Mutex
+	perKey      map[string]int
+	maxPerKey   int
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (c *concurrencyExtractor) enter(key string, delta int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.perKey[key] += delta
+	c.maxPerKey = max(c.maxPerKey, c.perKey[key])
+}
+
+func (c *concurrencyExtractor) ExtractNotification(_ context.Context, event fwkdl.NotificationEvent) error {
+	c.enter(event.Name(), 1)
+	defer c.enter(event.Name(), -1)
+	n := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+	for {
+		current := c.maxInFlight.Load()
+		if n <= current || c.maxInFlight.CompareAndSwap(current, n) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	return nil
+}
+
+func TestNotificationReconcilerSerializesPerKey(t *testing.T) {
+	ext := &concurrencyExtractor{NotificationExtractor: extractormocks.NewNotificationExtractor("ext"), perKey: map[string]int{}}
+	src := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK)
+	rn := newNotificationReconciler(nil, src, []fwkdl.NotificationExtractor{ext}, logr.Discard())
+
+	names := []string{"pod-0", "pod-1"}
+
+	var wg sync.WaitGroup
+	for _, name := range names {
+		for range 4 { // concurrent callers per object
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 10 {
+					obj := &unstructured.Unstructured{}
+					obj.SetNamespace("default")
+					obj.SetName(name)
+					_, err := rn.dispatch(context.Background(), logr.Discard(), &fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+					assert.NoError(t, err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	require.Equal(t, 1, ext.maxPerKey, "events of an object must not be processed concurrently")
+	assert.Equal(t, int32(2), ext.maxInFlight.Load(), "different objects should be processed in parallel")
+	assert.Empty(t, rn.keyLocks.keys, "released keys should be forgotten")
+}
+
+func TestKeyedMutex(t *testing.T) {
+	var m keyedMutex
+	unlock := m.lock("a")
+
+	// other keys are not held up, whatever their hash.
+	for i := range 100 {
+		m.lock(fmt.Sprintf("key-%d", i))()
+	}
+
+	locked := make(chan struct{})
+	go func() {
+		defer close(locked)
+		m.lock("a")()
+	}()
+	select {
+	case <-locked:
+		t.Fatal("a held key should not be locked again")
+	case <-time.After(20 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+	assert.Empty(t, m.keys)
+}
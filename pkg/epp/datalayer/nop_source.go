@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"reflect"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// NopDataSource is a PollingDataSource that produces no data, as a placeholder
+// when wiring optional features or in tests. Since it never returns data, its
+// extractors are never invoked.
+type NopDataSource struct {
+	typedName fwkplugin.TypedName
+}
+
+var _ fwkdl.PollingDataSource = (*NopDataSource)(nil)
+
+// NewNopDataSource returns a NopDataSource with the given plugin type and name.
+func NewNopDataSource(pluginType, pluginName string) *NopDataSource {
+	return &NopDataSource{typedName: fwkplugin.TypedName{Type: pluginType, Name: pluginName}}
+}
+
+// TypedName returns the plugin type and name.
+func (s *NopDataSource) TypedName() fwkplugin.TypedName {
+	return s.typedName
+}
+
+// OutputType returns the empty interface type, as the source produces no data.
+func (s *NopDataSource) OutputType() reflect.Type {
+	return reflect.TypeFor[any]()
+}
+
+// ExtractorType returns the base Extractor interface.
+func (s *NopDataSource) ExtractorType() reflect.Type {
+	return fwkdl.ExtractorType
+}
+
+// Poll returns no data and no error.
+func (s *NopDataSource) Poll(_ context.Context, _ fwkdl.Endpoint) (any, error) {
+	return nil, nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+func TestNopDataSource(t *testing.T) {
+	var src fwkdl.PollingDataSource = NewNopDataSource("nop", "placeholder")
+	assert.Equal(t, fwkplugin.TypedName{Type: "nop", Name: "placeholder"}, src.TypedName())
+
+	data, err := src.Poll(context.Background(), endpoint)
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+	assert.Equal(t, fwkdl.ExtractorType, src.ExtractorType())
+
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// indexField is the cache field of an index of a source. Sources watching the
+// same GVK share its informer, so the field is scoped to the source.
+func indexField(srcName, index string) string {
+	return "notify/" + srcName + "/" + index
+}
+
+// registerIndexes registers the indexes of a source with the field indexer of
+// the cache of obj, which must be called before the cache starts.
+func registerIndexes(ctx context.Context, indexer client.FieldIndexer, obj client.Object, srcName string,
+	indexes map[string]fwkdl.IndexFunc) error {
+	for _, index := range slices.Sorted(maps.Keys(indexes)) {
+		if err := indexer.IndexField(ctx, obj, indexField(srcName, index), indexerFunc(indexes[index])); err != nil {
+			return fmt.Errorf("failed to register index %q: %w", index, err)
+		}
+	}
+	return nil
+}
+
+// indexerFunc adapts fn to the cache, which may hold typed objects.
+func indexerFunc(fn fwkdl.IndexFunc) client.IndexerFunc {
+	return func(obj client.Object) []string {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			if err != nil {
+				return nil
+			}
+			u = &unstructured.Unstructured{Object: content}
+		}
+		return fn(u)
+	}
+}
+
+// newIndexLookup returns a LookupFunc listing the objects of gvk from reader, by
+// the indexes registered for the source.
+func newIndexLookup(reader client.Reader, gvk schema.GroupVersionKind, srcName string) fwkdl.LookupFunc {
+	return func(ctx context.Context, index, key string) ([]*unstructured.Unstructured, error) {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := reader.List(ctx, list, client.MatchingFields{indexField(srcName, index): key}); err != nil {
+			return nil, fmt.Errorf("failed to look up %s by index %q: %w", gvk.Kind, index, err)
+		}
+		objs := make([]*unstructured.Unstructured, len(list.Items))
+		for i := range list.Items {
+			objs[i] = &list.Items[i]
+		}
+		return objs, nil
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"time"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// EndpointTimeoutFunc returns the poll deadline for an endpoint; values <= 0 use
+// the default.
+type EndpointTimeoutFunc func(ep fwkdl.Endpoint) time.Duration
+
+// LabelTimeout returns an EndpointTimeoutFunc reading the deadline from the
+// endpoint label key, formatted as a Go duration (e.g., "2500ms"). Endpoints
+// without the label, or with an invalid value, use the default.
+func LabelTimeout(key string) EndpointTimeoutFunc {
+	return func(ep fwkdl.Endpoint) time.Duration {
+		meta := ep.GetMetadata()
+		if meta == nil {
+			return 0
+		}
+		timeout, err := time.ParseDuration(meta.Labels[key])
+		if err != nil {
+			return 0
+		}
+		return timeout
+	}
+}
+
+// WithEndpointTimeout wraps a polling source so each endpoint is polled with the
+// deadline returned by timeout. Optional interfaces of src other than
+// PollingDataSource (e.g., ValidatingDataSource) are not visible through the
+// wrapper.
+func WithEndpointTimeout(src fwkdl.PollingDataSource, timeout EndpointTimeoutFunc) fwkdl.PollingDataSource {
+	return &endpointTimeoutSource{PollingDataSource: src, timeout: timeout}
+}
+
+type endpointTimeoutSource struct {
+	fwkdl.PollingDataSource
+	timeout EndpointTimeoutFunc
+}
+
+var _ fwkdl.PollTimeoutSource = (*endpointTimeoutSource)(nil)
+
+func (s *endpointTimeoutSource) PollTimeout(ep fwkdl.Endpoint) time.Duration {
+	return s.timeout(ep)
+}
+
+// WithDefaultCollectTimeout wraps a polling source so each poll is bounded by
+// timeout when the caller's context has no deadline, e.g., for callers polling
+// the source directly, outside of the Runtime; a timeout <= 0 disables it.
+// Deadlines set by callers are left untouched. As with WithEndpointTimeout, other
+// optional interfaces of src are not visible through the wrapper.
+func WithDefaultCollectTimeout(src fwkdl.PollingDataSource, timeout time.Duration) fwkdl.PollingDataSource {
+	return &defaultTimeoutSource{PollingDataSource: src, timeout: timeout}
+}
+
+type defaultTimeoutSource struct {
+	fwkdl.PollingDataSource
+	timeout time.Duration
+}
+
+func (s *defaultTimeoutSource) Poll(ctx context.Context, ep fwkdl.Endpoint) (any, error) {
+	if _, ok := ctx.Deadline(); !ok && s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+	return s.PollingDataSource.Poll(ctx, ep)
+}
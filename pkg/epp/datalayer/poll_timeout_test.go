@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/mocks"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	datasourcemocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/mocks"
+)
+
+const pollTimeoutLabel = "inference.networking.k8s.io/poll-timeout"
+
+// deadlineSource records the time left until the poll deadline, per endpoint.
+type deadlineSource struct {
+	datasourcemocks.MetricsDataSource
+	mu        sync.Mutex
+	remaining map[string]time.Duration
+}
+
+func (d *deadlineSource) Poll(ctx context.Context, ep fwkdl.Endpoint) (any, error) {
+	deadline, _ := ctx.Deadline()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.remaining[ep.GetMetadata().NamespacedName.Name] = time.Until(deadline)
+	return nil, nil
+}
+
+func (d *deadlineSource) get(name string) (time.Duration, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	remaining, ok := d.remaining[name]
+	return remaining, ok
+}
+
+func newLabeledEndpoint(name string, labels map[string]string) fwkdl.Endpoint {
+	return fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: name, Namespace: "default"},
+		Address:        "1.2.3.4:5678",
+		Labels:         labels,
+	}, nil)
+}
+
+func TestLabelTimeout(t *testing.T) {
+	timeout := LabelTimeout(pollTimeoutLabel)
+
+	assert.Equal(t, 2500*time.Millisecond, timeout(newLabeledEndpoint("slow", map[string]string{pollTimeoutLabel: "2500ms"})))
+	assert.Zero(t, timeout(newLabeledEndpoint("unlabeled", nil)))
+	assert.Zero(t, timeout(newLabeledEndpoint("invalid", map[string]string{pollTimeoutLabel: "slow"})))
+}
+
+func TestCollectorAppliesEndpointTimeout(t *testing.T) {
+	source := &deadlineSource{remaining: make(map[string]time.Duration)}
+	wrapped := WithEndpointTimeout(source, LabelTimeout(pollTimeoutLabel))
+
+	endpoints := []fwkdl.Endpoint{
+		newLabeledEndpoint("slow", map[string]string{pollTimeoutLabel: "5s"}),
+		newLabeledEndpoint("fast", nil),
+	}
+	for _, ep := range endpoints {
+		c := NewCollector()
+		ticker := mocks.NewTicker()
+		require.NoError(t, c.Start(context.Background(), ticker, ep, []fwkdl.PollingDataSource{wrapped}, nil))
+		ticker.Tick()
+		require.Eventually(t, func() bool {
+			_, ok := source.get(ep.GetMetadata().NamespacedName.Name)
+			return ok
+		}, time.Second, 2*time.Millisecond)
+		require.NoError(t, c.Stop())
+	}
+
+	slow, _ := source.get("slow")
+	fast, _ := source.get("fast")
+	assert.InDelta(t, 5*time.Second, slow, float64(500*time.Millisecond), "labeled endpoint should use its own deadline")
+	assert.InDelta(t, defaultCollectionTimeout, fast, float64(500*time.Millisecond), "unlabeled endpoint should use the default")
+}
+
+func TestWithDefaultCollectTimeout(t *testing.T) {
+	recorder := &deadlineSource{remaining: map[string]time.Duration{}}
+	src := WithDefaultCollectTimeout(recorder, 2*time.Second)
+
+	_, err := src.Poll(context.Background(), newLabeledEndpoint("no-deadline", nil))
+	require.NoError(t, err)
+	remaining, ok := recorder.get("no-deadline")
+	require.True(t, ok)
+	assert.Greater(t, remaining, time.Duration(0), "the default should apply without a caller deadline")
+	assert.LessOrEqual(t, remaining, 2*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = src.Poll(ctx, newLabeledEndpoint("caller-deadline", nil))
+	require.NoError(t, err)
+	remaining, _ = recorder.get("caller-deadline")
+	assert.Greater(t, remaining, 30*time.Second, "the caller deadline should be kept")
+
+	_, err = WithDefaultCollectTimeout(recorder, 0).Poll(context.Background(), newLabeledEndpoint("disabled", nil))
+	require.NoError(t, err)
+	remaining, _ = recorder.get("disabled")
+	assert.Less(t, remaining, time.Duration(0), "no deadline should be set when disabled")
+}
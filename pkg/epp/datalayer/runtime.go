@@ -17,10 +17,12 @@ limitations under the License.
 package datalayer
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 )
 
 // Runtime manages data sources, extractors, their mapping, and endpoint lifecycle.
@@ -40,8 +43,30 @@ type Runtime struct {
 	endpointSources  sync.Map // Map of endpoint sources (key=source name, value=EndpointSource)
 	sourceExtractors sync.Map // Map sources to extractors (key=source name. value=[]Extractor)
 
-	collectors sync.Map    // Per-endpoint poller (key=namespaced name, value=*Collector)
+	collectors sync.Map    // Per-endpoint poller (key=EndpointKey, value=*Collector)
 	logger     logr.Logger // Set in Configure; used where no context is available (e.g. ReleaseEndpoint).
+
+	extractorsMu            sync.Mutex              // serializes extractor registration after Configure
+	disallowedExtractorType string                  // set in Configure; also enforced by AddExtractor
+	sequences               map[extractorKey]uint64 // registration sequence of each extractor; guarded by extractorsMu
+	nextSequence            uint64                  // guarded by extractorsMu
+	lifecycleHook           ExtractorLifecycleHook  // guarded by extractorsMu
+}
+
+// extractorKey identifies an extractor registered with a source.
+type extractorKey struct {
+	source string
+	name   string
+}
+
+// ExtractorInfo describes an extractor registered with the Runtime.
+type ExtractorInfo struct {
+	Source    string              // name of the source the extractor is registered with
+	Extractor fwkplugin.TypedName // the extractor
+	// Sequence increases with registration order across all sources: extractors
+	// from the configuration are numbered in configured order, followed by those
+	// added with AddExtractor. Replacing an extractor keeps its sequence.
+	Sequence uint64
 }
 
 const (
@@ -71,69 +96,307 @@ func (r *Runtime) Configure(cfg *Config, enableNewMetrics bool, disallowedExtrac
 		return nil
 	}
 
-	r.logger = logger
 	logger.Info("Configuring datalayer runtime", "numSources", len(cfg.Sources))
 
-	pollersCount := 0
-	notifiersCount := 0
-	endpointSourcesCount := 0
+	// Sources and extractors are staged and only committed to the Runtime once the
+	// whole configuration is valid, so a failure never leaves it half-configured.
+	pollers := make(map[string]fwkdl.PollingDataSource)
+	notifiers := make(map[string]fwkdl.NotificationSource)
+	endpointSources := make(map[string]fwkdl.EndpointSource)
+	sourceExtractors := make(map[string][]fwkdl.Extractor)
 	gvkToSource := make(map[string]string, len(cfg.Sources)) // track GVK uniqueness
 
 	for _, srcCfg := range cfg.Sources {
 		src := srcCfg.Plugin
 		srcName := src.TypedName().Name
 
-		logger.V(logging.DEFAULT).Info("Processing source", "source", srcName, "numExtractors", len(srcCfg.Extractors))
-		if err := r.validateSourceExtractors(src, srcCfg.Extractors, disallowedExtractorType); err != nil {
+		configured := sourceConfigExtractors(srcCfg)
+		logger.V(logging.DEFAULT).Info("Processing source", "source", srcName, "numExtractors", len(configured))
+		if err := r.validateSourceExtractors(src, configured, disallowedExtractorType); err != nil {
 			return err
 		}
+		extractors, err := orderExtractors(configured)
+		if err != nil {
+			return fmt.Errorf("invalid extractor dependencies for source %s: %w", src.TypedName().String(), err)
+		}
 
 		if poller, ok := src.(fwkdl.PollingDataSource); ok { // Register to appropriate map based on type
-			r.pollers.Store(srcName, poller)
-			pollersCount++
+			pollers[srcName] = poller
 		} else if notifier, ok := src.(fwkdl.NotificationSource); ok {
 			gvk := notifier.GVK().String()
 			if existingSource, exists := gvkToSource[gvk]; exists {
 				return fmt.Errorf("duplicate notification source GVK %s: already used by source %s, cannot add %s",
 					gvk, existingSource, src.TypedName().String())
 			}
-			r.notifiers.Store(srcName, notifier)
+			notifiers[srcName] = notifier
 			gvkToSource[gvk] = srcName
-			notifiersCount++
 		} else if epSrc, ok := src.(fwkdl.EndpointSource); ok {
-			r.endpointSources.Store(srcName, epSrc)
-			endpointSourcesCount++
+			endpointSources[srcName] = epSrc
 		} else {
 			return fmt.Errorf("skipping unknown datasource plugin type %s", src.TypedName().String())
 		}
 
-		if len(srcCfg.Extractors) > 0 { // Store extractors mapped to source
-			r.sourceExtractors.Store(srcName, srcCfg.Extractors)
+		if len(extractors) > 0 { // Map extractors to source, in dispatch order
+			sourceExtractors[srcName] = extractors
 		}
 
-		extractorNames := make([]string, len(srcCfg.Extractors))
-		for i, ext := range srcCfg.Extractors {
+		extractorNames := make([]string, len(extractors))
+		for i, ext := range extractors {
 			extractorNames[i] = ext.TypedName().String()
 		}
 		logger.V(logging.DEFAULT).Info("Source configured", "source", srcName, "extractors", extractorNames)
 	}
 
-	logger.Info("Datalayer runtime configured", "pollers", pollersCount, "notifiers", notifiersCount, "endpointSources", endpointSourcesCount)
+	r.logger = logger
+	r.disallowedExtractorType = disallowedExtractorType
+	for name, poller := range pollers {
+		r.pollers.Store(name, poller)
+	}
+	for name, notifier := range notifiers {
+		r.notifiers.Store(name, notifier)
+	}
+	for name, epSrc := range endpointSources {
+		r.endpointSources.Store(name, epSrc)
+	}
+	for name, extractors := range sourceExtractors {
+		r.sourceExtractors.Store(name, extractors)
+	}
+
+	r.extractorsMu.Lock()
+	for _, srcCfg := range cfg.Sources { // registration order, not dispatch order
+		for _, ext := range sourceConfigExtractors(srcCfg) {
+			r.assignSequence(extractorKey{source: srcCfg.Plugin.TypedName().Name, name: ext.TypedName().Name})
+		}
+	}
+	r.extractorsMu.Unlock()
+
+	logger.Info("Datalayer runtime configured", "pollers", len(pollers), "notifiers", len(notifiers), "endpointSources", len(endpointSources))
 	return nil
 }
 
+// sourceConfigExtractors returns the extractors the source was constructed with,
+// if any, followed by those configured for it.
+func sourceConfigExtractors(srcCfg DataSourceConfig) []fwkdl.Extractor {
+	initial, ok := srcCfg.Plugin.(fwkdl.InitialExtractorsSource)
+	if !ok {
+		return srcCfg.Extractors
+	}
+	return append(slices.Clone(initial.InitialExtractors()), srcCfg.Extractors...)
+}
+
+// AddExtractor registers an extractor with a configured source. It fails if the
+// source is unknown, the extractor is incompatible with it, or the source already
+// has an extractor with the same name. Extractors added after Start only apply to
+// endpoints created afterwards and are not seen by notification sources.
+func (r *Runtime) AddExtractor(srcName string, ext fwkdl.Extractor) error {
+	return r.addExtractors(srcName, []fwkdl.Extractor{ext}, false)
+}
+
+// AddExtractors registers several extractors with a configured source, as
+// AddExtractor does, all or none: if any of them is rejected, including for
+// sharing a name with another of them, the source is left unchanged.
+func (r *Runtime) AddExtractors(srcName string, exts ...fwkdl.Extractor) error {
+	return r.addExtractors(srcName, exts, false)
+}
+
+// AddOrReplaceExtractor is an idempotent AddExtractor, for callers reconciling a
+// desired state: re-adding the registered extractor instance is a no-op, and an
+// extractor with the name of a registered one replaces it in place. As with
+// AddExtractor, notification sources bound at Start do not see the change, so an
+// extractor added to a synced source is neither notified nor warmed up with the
+// objects of its cache.
+func (r *Runtime) AddOrReplaceExtractor(srcName string, ext fwkdl.Extractor) error {
+	return r.addExtractors(srcName, []fwkdl.Extractor{ext}, true)
+}
+
+// addExtractors registers exts with the source, committing them only once all
+// are validated.
+func (r *Runtime) addExtractors(srcName string, exts []fwkdl.Extractor, replace bool) error {
+	if slices.Contains(exts, nil) {
+		return fmt.Errorf("nil extractor for source %s", srcName)
+	}
+	src, ok := r.lookupSource(srcName)
+	if !ok {
+		return fmt.Errorf("unknown data source %s", srcName)
+	}
+	if err := r.validateSourceExtractors(src, exts, r.disallowedExtractorType); err != nil {
+		return err
+	}
+
+	var notify []func()
+	defer func() { // deferred first, so the hook runs once the lock is released
+		for _, fn := range notify {
+			fn()
+		}
+	}()
+	r.extractorsMu.Lock()
+	defer r.extractorsMu.Unlock()
+
+	var current []fwkdl.Extractor
+	if raw, ok := r.sourceExtractors.Load(srcName); ok {
+		current = raw.([]fwkdl.Extractor)
+	}
+
+	// the registered slice may be in use by collectors, so always build a new one.
+	updated := slices.Clone(current)
+	changed := make([]bool, len(exts)) // false for the registered instance, re-added
+	replaced := make([]bool, len(exts))
+	for i, ext := range exts {
+		idx := slices.IndexFunc(updated, func(existing fwkdl.Extractor) bool {
+			return existing.TypedName().Name == ext.TypedName().Name
+		})
+		switch {
+		case idx < 0:
+			updated = append(updated, ext)
+			changed[i] = true
+		case !replace || idx >= len(current): // added by an earlier member of exts
+			return fmt.Errorf("extractor %s already registered with source %s", ext.TypedName(), src.TypedName())
+		case !sameExtractor(updated[idx], ext):
+			updated[idx] = ext
+			changed[i], replaced[i] = true, true
+		}
+	}
+	if !slices.Contains(changed, true) {
+		return nil
+	}
+
+	ordered, err := orderExtractors(updated)
+	if err != nil {
+		return fmt.Errorf("invalid extractor dependencies for source %s: %w", src.TypedName().String(), err)
+	}
+	r.sourceExtractors.Store(srcName, ordered)
+	for i, ext := range exts {
+		if !changed[i] {
+			continue
+		}
+		key := extractorKey{source: srcName, name: ext.TypedName().Name}
+		if !replaced[i] {
+			r.assignSequence(key)
+		}
+		notify = append(notify, r.newLifecycleEvent(ExtractorAdded, src.TypedName(), ext.TypedName(), replaced[i]))
+	}
+	return nil
+}
+
+// RemoveExtractor detaches the named extractor from a configured source. It fails
+// if the source is unknown, the extractor is not registered with it, or another
+// extractor of the source depends on it. As with AddExtractor, the change only
+// applies to endpoints created afterwards.
+func (r *Runtime) RemoveExtractor(srcName, extName string) error {
+	src, ok := r.lookupSource(srcName)
+	if !ok {
+		return fmt.Errorf("unknown data source %s", srcName)
+	}
+
+	notify := func() {}
+	defer func() { notify() }()
+	r.extractorsMu.Lock()
+	defer r.extractorsMu.Unlock()
+
+	var current []fwkdl.Extractor
+	if raw, ok := r.sourceExtractors.Load(srcName); ok {
+		current = raw.([]fwkdl.Extractor)
+	}
+	idx := slices.IndexFunc(current, func(ext fwkdl.Extractor) bool { return ext.TypedName().Name == extName })
+	if idx < 0 {
+		return fmt.Errorf("extractor %s not registered with source %s", extName, src.TypedName())
+	}
+	removed := current[idx]
+
+	updated := slices.Delete(slices.Clone(current), idx, idx+1)
+	if _, err := orderExtractors(updated); err != nil {
+		return fmt.Errorf("cannot remove extractor %s from source %s: %w", removed.TypedName(), src.TypedName(), err)
+	}
+
+	if len(updated) == 0 {
+		r.sourceExtractors.Delete(srcName)
+	} else {
+		r.sourceExtractors.Store(srcName, updated) // removal preserves dispatch order
+	}
+	delete(r.sequences, extractorKey{source: srcName, name: extName})
+	notify = r.newLifecycleEvent(ExtractorRemoved, src.TypedName(), removed.TypedName(), false)
+	return nil
+}
+
+// assignSequence records the next registration sequence for the extractor. The
+// caller must hold extractorsMu.
+func (r *Runtime) assignSequence(key extractorKey) {
+	if r.sequences == nil {
+		r.sequences = make(map[extractorKey]uint64)
+	}
+	r.nextSequence++
+	r.sequences[key] = r.nextSequence
+}
+
+// Extractors returns the registered extractors of all sources, in registration
+// order.
+func (r *Runtime) Extractors() []ExtractorInfo {
+	r.extractorsMu.Lock()
+	defer r.extractorsMu.Unlock()
+
+	var infos []ExtractorInfo
+	r.sourceExtractors.Range(func(key, value any) bool {
+		srcName := key.(string)
+		for _, ext := range value.([]fwkdl.Extractor) {
+			infos = append(infos, ExtractorInfo{
+				Source:    srcName,
+				Extractor: ext.TypedName(),
+				Sequence:  r.sequences[extractorKey{source: srcName, name: ext.TypedName().Name}],
+			})
+		}
+		return true
+	})
+	slices.SortFunc(infos, func(a, b ExtractorInfo) int { return cmp.Compare(a.Sequence, b.Sequence) })
+	return infos
+}
+
+// lookupSource returns the configured source with the given name.
+func (r *Runtime) lookupSource(srcName string) (fwkdl.DataSource, bool) {
+	for _, sources := range []*sync.Map{&r.pollers, &r.notifiers, &r.endpointSources} {
+		if src, ok := sources.Load(srcName); ok {
+			return src.(fwkdl.DataSource), true
+		}
+	}
+	return nil, false
+}
+
+// sameExtractor reports whether a and b are the same extractor instance.
+func sameExtractor(a, b fwkdl.Extractor) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	return va.Type() == vb.Type() && va.Comparable() && va.Equal(vb)
+}
+
+// ExtractorsAs returns the extractors registered with the named source that
+// implement T (e.g., a capability interface), in dispatch order. It returns nil
+// for unknown sources. The returned slice is owned by the caller.
+func ExtractorsAs[T any](r *Runtime, srcName string) []T {
+	raw, ok := r.sourceExtractors.Load(srcName)
+	if !ok {
+		return nil
+	}
+	var matches []T
+	for _, ext := range raw.([]fwkdl.Extractor) {
+		if typed, ok := ext.(T); ok {
+			matches = append(matches, typed)
+		}
+	}
+	return matches
+}
+
 // Start is called to enable the Runtime to start processing data collection. It wires
 // Kubernetes notifications into the manager.
 func (r *Runtime) Start(ctx context.Context, mgr ctrl.Manager) error {
 	var err error
+	var summaries []SourceSummary
 
 	r.notifiers.Range(func(key, val any) bool { // bind notification sources to the manager
 		ns := val.(fwkdl.NotificationSource)
 		srcName := ns.TypedName().Name
 
+		var raw []fwkdl.Extractor
 		var extractors []fwkdl.NotificationExtractor
 		if rawExts, ok := r.sourceExtractors.Load(srcName); ok {
-			raw := rawExts.([]fwkdl.Extractor)
+			raw = rawExts.([]fwkdl.Extractor)
 			extractors = make([]fwkdl.NotificationExtractor, len(raw))
 			for i, e := range raw {
 				extractors[i] = e.(fwkdl.NotificationExtractor)
@@ -144,8 +407,13 @@ func (r *Runtime) Start(ctx context.Context, mgr ctrl.Manager) error {
 			err = fmt.Errorf("failed to bind notification source %s: %w", ns.TypedName(), bindErr)
 			return false
 		}
+		summaries = append(summaries, describeSource(ns, raw))
 		return true
 	})
+	if err == nil {
+		sortSummaries(summaries)
+		r.logger.Info("Notification sources bound", "sources", summaries)
+	}
 	return err
 }
 
@@ -193,7 +461,7 @@ func (r *Runtime) NewEndpoint(ctx context.Context, endpointMetadata *fwkdl.Endpo
 	endpoint := fwkdl.NewEndpoint(endpointMetadata, nil)
 	collector := NewCollector()
 
-	key := endpointMetadata.GetNamespacedName()
+	key := fwkdl.EndpointKey(endpoint)
 	if _, loaded := r.collectors.LoadOrStore(key, collector); loaded {
 		logger.V(logging.DEFAULT).Info("collector already running for endpoint", "endpoint", key)
 		return nil
@@ -214,7 +482,7 @@ func (r *Runtime) NewEndpoint(ctx context.Context, endpointMetadata *fwkdl.Endpo
 func (r *Runtime) ReleaseEndpoint(ep fwkdl.Endpoint) {
 	r.dispatchEndpointEvent(context.Background(), r.logger, fwkdl.EndpointEvent{Type: fwkdl.EventDelete, Endpoint: ep})
 
-	key := ep.GetMetadata().GetNamespacedName()
+	key := fwkdl.EndpointKey(ep)
 	if value, ok := r.collectors.LoadAndDelete(key); ok {
 		collector := value.(*Collector)
 		_ = collector.Stop()
@@ -246,7 +514,7 @@ func (r *Runtime) dispatchEndpointEvent(ctx context.Context, logger logr.Logger,
 		}
 		for _, ext := range rawExts.([]fwkdl.Extractor) {
 			if epExt, ok := ext.(fwkdl.EndpointExtractor); ok {
-				if err := epExt.ExtractEndpoint(ctx, *processed); err != nil {
+				if err := epExt.ExtractEndpoint(ctx, *processed); err != nil && !errors.Is(err, fwkdl.ErrSkip) {
 					logger.Error(err, "endpoint extractor failed", "extractor", ext.TypedName())
 				}
 			}
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
+)
+
+func newConfiguredRuntime(t *testing.T, extractors ...fwkdl.Extractor) *Runtime {
+	t.Helper()
+	r := NewRuntime(1)
+	cfg := &Config{
+		Sources: []DataSourceConfig{
+			{Plugin: mocks.NewNotificationSource("test", "pods", podGVK), Extractors: extractors},
+		},
+	}
+	require.NoError(t, r.Configure(cfg, false, "", newTestLogger(t)))
+	return r
+}
+
+func registeredExtractors(t *testing.T, r *Runtime, srcName string) []fwkdl.Extractor {
+	t.Helper()
+	raw, ok := r.sourceExtractors.Load(srcName)
+	require.True(t, ok, "no extractors registered for source %s", srcName)
+	return raw.([]fwkdl.Extractor)
+}
+
+func TestRuntimeAddExtractor(t *testing.T) {
+	a := extractormocks.NewNotificationExtractor("a")
+	r := newConfiguredRuntime(t, a)
+
+	b := extractormocks.NewNotificationExtractor("b")
+	require.NoError(t, r.AddExtractor("pods", b))
+	assert.Equal(t, []fwkdl.Extractor{a, b}, registeredExtractors(t, r, "pods"))
+
+	err := r.AddExtractor("pods", a)
+	assert.ErrorContains(t, err, "already registered", "re-adding the same instance")
+	err = r.AddExtractor("pods", extractormocks.NewNotificationExtractor("a"))
+	assert.ErrorContains(t, err, "already registered", "adding a different extractor with the same name")
+
+	assert.ErrorContains(t, r.AddExtractor("unknown", b), "unknown data source")
+	assert.Equal(t, []fwkdl.Extractor{a, b}, registeredExtractors(t, r, "pods"), "failed adds must not modify the source")
+}
+
+func TestRuntimeAddExtractors(t *testing.T) {
+	a := extractormocks.NewNotificationExtractor("a")
+	r := newConfiguredRuntime(t, a)
+	b, c := extractormocks.NewNotificationExtractor("b"), extractormocks.NewNotificationExtractor("c")
+
+	invalid := extractormocks.NewNotificationExtractor("d").WithGVK(podGVK.GroupVersion().WithKind("Service"))
+	assert.Error(t, r.AddExtractors("pods", b, invalid), "an incompatible member")
+	assert.ErrorContains(t, r.AddExtractors("pods", b, extractormocks.NewNotificationExtractor("a")), "already registered",
+		"a member named as a registered extractor")
+	assert.ErrorContains(t, r.AddExtractors("pods", b, extractormocks.NewNotificationExtractor("b")), "already registered",
+		"two members sharing a name")
+	assert.ErrorContains(t, r.AddExtractors("pods", b, newDependentExtractor("e", "unknown")), "invalid extractor dependencies")
+	assert.ErrorContains(t, r.AddExtractors("pods", b, nil), "nil extractor")
+	assert.Equal(t, []fwkdl.Extractor{a}, registeredExtractors(t, r, "pods"), "failed adds must not modify the source")
+
+	require.NoError(t, r.AddExtractors("pods", b, c))
+	assert.Equal(t, []fwkdl.Extractor{a, b, c}, registeredExtractors(t, r, "pods"))
+	infos := r.Extractors()
+	require.Len(t, infos, 3)
+	assert.Less(t, infos[1].Sequence, infos[2].Sequence, "members should be numbered in order")
+}
+
+func TestRuntimeAddOrReplaceExtractor(t *testing.T) {
+	a := extractormocks.NewNotificationExtractor("a")
+	b := extractormocks.NewNotificationExtractor("b")
+	r := newConfiguredRuntime(t, a, b)
+
+	t.Run("re-add same instance", func(t *testing.T) {
+		require.NoError(t, r.AddOrReplaceExtractor("pods", a))
+		assert.Equal(t, []fwkdl.Extractor{a, b}, registeredExtractors(t, r, "pods"))
+	})
+
+	t.Run("replace different extractor in place", func(t *testing.T) {
+		replacement := extractormocks.NewNotificationExtractor("a")
+		require.NoError(t, r.AddOrReplaceExtractor("pods", replacement))
+
+		got := registeredExtractors(t, r, "pods")
+		require.Len(t, got, 2)
+		assert.Same(t, replacement, got[0])
+		assert.Same(t, b, got[1])
+	})
+
+	t.Run("invalid replacement rejected", func(t *testing.T) {
+		before := registeredExtractors(t, r, "pods")
+		invalid := extractormocks.NewNotificationExtractor("b").WithGVK(podGVK.GroupVersion().WithKind("Service"))
+		assert.Error(t, r.AddOrReplaceExtractor("pods", invalid))
+		assert.Equal(t, before, registeredExtractors(t, r, "pods"))
+	})
+}
+
+func TestExtractorsAs(t *testing.T) {
+	a := extractormocks.NewNotificationExtractor("a")
+	b := newDependentExtractor("b", "a")
+	c := extractormocks.NewNotificationExtractor("c")
+	d := newDependentExtractor("d")
+	r := newConfiguredRuntime(t, a, b, c, d)
+
+	deps := ExtractorsAs[fwkdl.DependentExtractor](r, "pods")
+	assert.Equal(t, []fwkdl.DependentExtractor{b, d}, deps)
+	assert.Len(t, ExtractorsAs[fwkdl.NotificationExtractor](r, "pods"), 4)
+	assert.Empty(t, ExtractorsAs[fwkdl.EndpointExtractor](r, "pods"))
+	assert.Nil(t, ExtractorsAs[fwkdl.Extractor](r, "unknown"))
+}
+
+func TestRuntimeExtractorSequence(t *testing.T) {
+	// b depends on a, so it is dispatched after a but was registered before it.
+	b := newDependentExtractor("b", "a")
+	a := extractormocks.NewNotificationExtractor("a")
+	r := newConfiguredRuntime(t, b, a)
+	require.NoError(t, r.AddExtractor("pods", extractormocks.NewNotificationExtractor("c")))
+	require.NoError(t, r.AddOrReplaceExtractor("pods", extractormocks.NewNotificationExtractor("b")))
+	require.NoError(t, r.AddExtractor("pods", extractormocks.NewNotificationExtractor("d")))
+
+	infos := r.Extractors()
+	names := make([]string, len(infos))
+	for i, info := range infos {
+		assert.Equal(t, "pods", info.Source)
+		if i > 0 {
+			assert.Greater(t, info.Sequence, infos[i-1].Sequence)
+		}
+		names[i] = info.Extractor.Name
+	}
+	assert.Equal(t, []string{"b", "a", "c", "d"}, names, "replacing b should keep its registration sequence")
+}
+
+func TestRuntimeConfigureInitialExtractors(t *testing.T) {
+	initial := extractormocks.NewNotificationExtractor("initial")
+	configured := extractormocks.NewNotificationExtractor("configured")
+	src, err := notifications.NewK8sNotificationSourceE(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithExtractors(initial))
+	require.NoError(t, err)
+
+	r := NewRuntime(1)
+	cfg := &Config{Sources: []DataSourceConfig{{Plugin: src, Extractors: []fwkdl.Extractor{configured}}}}
+	require.NoError(t, r.Configure(cfg, false, "", newTestLogger(t)))
+	assert.Equal(t, []fwkdl.Extractor{initial, configured}, registeredExtractors(t, r, "pods"))
+}
+
+func TestRuntimeRemoveExtractor(t *testing.T) {
+	a := extractormocks.NewNotificationExtractor("a")
+	b := newDependentExtractor("b", "a")
+	c := extractormocks.NewNotificationExtractor("c")
+	r := newConfiguredRuntime(t, a, b, c)
+
+	assert.ErrorContains(t, r.RemoveExtractor("unknown", "a"), "unknown data source")
+	assert.ErrorContains(t, r.RemoveExtractor("pods", "missing"), "not registered")
+	assert.ErrorContains(t, r.RemoveExtractor("pods", "a"), "depends on unknown extractor", "b depends on a")
+	assert.Equal(t, []fwkdl.Extractor{a, b, c}, registeredExtractors(t, r, "pods"), "failed removals must not modify the source")
+
+	require.NoError(t, r.RemoveExtractor("pods", "b"))
+	require.NoError(t, r.RemoveExtractor("pods", "a"))
+	assert.Equal(t, []fwkdl.Extractor{c}, registeredExtractors(t, r, "pods"))
+	assert.Equal(t, []string{"c"}, extractorInfoNames(r.Extractors()))
+
+	require.NoError(t, r.RemoveExtractor("pods", "c"))
+	assert.Empty(t, r.Extractors())
+}
+
+func TestRuntimeExtractorLifecycleHook(t *testing.T) {
+	r := newConfiguredRuntime(t)
+	var events []ExtractorLifecycleEvent
+	var registered [][]string
+	r.SetExtractorLifecycleHook(func(event ExtractorLifecycleEvent) {
+		events = append(events, event)
+		registered = append(registered, extractorInfoNames(r.Extractors())) // hooks may call back into the runtime
+	})
+
+	ext := extractormocks.NewNotificationExtractor("a")
+	require.NoError(t, r.AddExtractor("pods", ext))
+	require.Error(t, r.AddExtractor("pods", ext), "failed changes emit no event")
+	require.NoError(t, r.RemoveExtractor("pods", "a"))
+
+	require.Len(t, events, 2)
+	assert.Equal(t, ExtractorAdded, events[0].Action)
+	assert.Equal(t, ExtractorRemoved, events[1].Action)
+	for _, event := range events {
+		assert.Equal(t, "pods", event.Source.Name)
+		assert.Equal(t, ext.TypedName(), event.Extractor)
+		assert.False(t, event.Time.IsZero())
+	}
+	assert.False(t, events[1].Time.Before(events[0].Time))
+	assert.Equal(t, [][]string{{"a"}, nil}, registered, "hooks run after the change is visible")
+}
+
+func extractorInfoNames(infos []ExtractorInfo) []string {
+	var names []string
+	for _, info := range infos {
+		names = append(names, info.Extractor.Name)
+	}
+	return names
+}
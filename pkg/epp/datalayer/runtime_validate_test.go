@@ -20,10 +20,12 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/mocks"
 )
 
@@ -164,3 +166,31 @@ func TestRuntimeConfigureDuplicateGVKFails(t *testing.T) {
 	assert.Error(t, err, "Configure should fail with duplicate GVK")
 	assert.Contains(t, err.Error(), "duplicate", "Error should mention duplicate GVK")
 }
+
+func TestRuntimeConfigureFailureLeavesRuntimeUnchanged(t *testing.T) {
+	logger := newTestLogger(t)
+	r := NewRuntime(1)
+
+	gvk := schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+	wrongGVK := schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"}
+	cfg := &Config{
+		Sources: []DataSourceConfig{
+			{Plugin: &mocks.MetricsDataSource{}},
+			{
+				Plugin: mocks.NewNotificationSource("test", "pods", gvk),
+				Extractors: []fwkdl.Extractor{
+					extractormocks.NewNotificationExtractor("valid"),
+					extractormocks.NewNotificationExtractor("invalid").WithGVK(wrongGVK),
+				},
+			},
+		},
+	}
+
+	err := r.Configure(cfg, false, "disallowed", logger)
+	assert.Error(t, err, "Configure should fail on an invalid extractor")
+	assert.True(t, isEmpty(&r.pollers), "no pollers should be registered")
+	assert.True(t, isEmpty(&r.notifiers), "no notifiers should be registered")
+	assert.True(t, isEmpty(&r.sourceExtractors), "no extractors should be registered")
+	assert.Empty(t, r.disallowedExtractorType, "the disallowed extractor type should not be set")
+	assert.Equal(t, logr.Discard(), r.logger, "the logger should not be set")
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/cespare/xxhash/v2"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// errEventNotSampled is returned by sampling extractors for events of objects
+// outside of their sample.
+var errEventNotSampled = fmt.Errorf("object not sampled: %w", fwkdl.ErrSkip)
+
+// SamplingExtractor decorates a NotificationExtractor to only process the events
+// of a fraction of the objects, e.g., to canary an experimental extractor with a
+// limited blast radius. Objects are sampled by a hash of their namespace and
+// name, so an object is consistently included or excluded, including by its
+// delete event, whose object may only carry those. Other events are skipped.
+type SamplingExtractor struct {
+	fwkdl.NotificationExtractor
+	threshold uint64 // objects hashing below it are sampled
+	all       bool   // the fraction is 1: the threshold can't represent it
+}
+
+var _ fwkdl.NotificationExtractor = (*SamplingExtractor)(nil)
+
+// NewSamplingExtractor wraps ext to process the events of the given fraction of
+// objects, between 0 and 1.
+func NewSamplingExtractor(ext fwkdl.NotificationExtractor, fraction float64) (*SamplingExtractor, error) {
+	if math.IsNaN(fraction) || fraction < 0 || fraction > 1 {
+		return nil, fmt.Errorf("invalid sampling fraction %v for extractor %s: must be between 0 and 1", fraction, ext.TypedName())
+	}
+	return &SamplingExtractor{
+		NotificationExtractor: ext,
+		threshold:             uint64(fraction * math.MaxUint64),
+		all:                   fraction == 1,
+	}, nil
+}
+
+// DependsOn implements fwkdl.DependentExtractor.
+func (s *SamplingExtractor) DependsOn() []string {
+	return dependsOnOf(s.NotificationExtractor)
+}
+
+// ExtractNotification invokes the wrapped extractor if the event object is
+// sampled.
+func (s *SamplingExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	if !s.sampled(event) {
+		return errEventNotSampled
+	}
+	return s.NotificationExtractor.ExtractNotification(ctx, event)
+}
+
+func (s *SamplingExtractor) sampled(event fwkdl.NotificationEvent) bool {
+	if s.all {
+		return true
+	}
+	return xxhash.Sum64String(event.Namespace()+"/"+event.Name()) < s.threshold
+}
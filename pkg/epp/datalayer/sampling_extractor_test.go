@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+)
+
+func newUIDEvent(uid string) fwkdl.NotificationEvent {
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("default")
+	obj.SetName("pod-" + uid)
+	obj.SetUID(types.UID(uid))
+	return fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj}
+}
+
+func TestSamplingExtractor(t *testing.T) {
+	ctx := context.Background()
+	for _, fraction := range []float64{0, 0.1, 0.5, 1} {
+		t.Run(fmt.Sprintf("fraction %v", fraction), func(t *testing.T) {
+			inner := extractormocks.NewNotificationExtractor("canary")
+			ext, err := NewSamplingExtractor(inner, fraction)
+			require.NoError(t, err)
+
+			const objects = 10000
+			for i := range objects {
+				err := ext.ExtractNotification(ctx, newUIDEvent(fmt.Sprintf("uid-%d", i)))
+				if err != nil {
+					require.ErrorIs(t, err, fwkdl.ErrSkip)
+				}
+			}
+			ratio := float64(len(inner.GetEvents())) / objects
+			assert.InDelta(t, fraction, ratio, 0.02)
+		})
+	}
+}
+
+func TestSamplingExtractorConsistency(t *testing.T) {
+	ctx := context.Background()
+	inner := extractormocks.NewNotificationExtractor("canary")
+	ext, err := NewSamplingExtractor(inner, 0.5)
+	require.NoError(t, err)
+
+	for i := range 100 {
+		event := newUIDEvent(fmt.Sprintf("uid-%d", i))
+		first := ext.ExtractNotification(ctx, event)
+		event.Object.SetResourceVersion("2") // the object changed
+		assert.Equal(t, errors.Is(first, fwkdl.ErrSkip), errors.Is(ext.ExtractNotification(ctx, event), fwkdl.ErrSkip),
+			"the same object should be consistently included or excluded")
+	}
+}
+
+func TestSamplingExtractorDeletes(t *testing.T) {
+	ctx := context.Background()
+	inner := extractormocks.NewNotificationExtractor("canary")
+	ext, err := NewSamplingExtractor(inner, 0.5)
+	require.NoError(t, err)
+
+	for i := range 100 {
+		event := newUIDEvent(fmt.Sprintf("uid-%d", i))
+		added := ext.ExtractNotification(ctx, event)
+		event.Object.SetResourceVersion("2")
+		updated := ext.ExtractNotification(ctx, event)
+		// delete events of the core only carry the namespace and name.
+		deleted := &unstructured.Unstructured{}
+		deleted.SetNamespace(event.Namespace())
+		deleted.SetName(event.Name())
+		removed := ext.ExtractNotification(ctx, fwkdl.NotificationEvent{Type: fwkdl.EventDelete, Object: deleted})
+
+		skipped := errors.Is(added, fwkdl.ErrSkip)
+		assert.Equal(t, skipped, errors.Is(updated, fwkdl.ErrSkip), "object %d was updated", i)
+		assert.Equal(t, skipped, errors.Is(removed, fwkdl.ErrSkip), "object %d should be deleted as it was added", i)
+	}
+	assert.NotEmpty(t, inner.GetEvents())
+}
+
+func TestSamplingExtractorInvalidFraction(t *testing.T) {
+	for _, fraction := range []float64{-0.1, 1.5, math.NaN()} {
+		_, err := NewSamplingExtractor(extractormocks.NewNotificationExtractor("canary"), fraction)
+		assert.Error(t, err, "fraction %v", fraction)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// ErrSchemaViolation is returned by schema validating extractors for event
+// objects not matching their schema.
+var ErrSchemaViolation = errors.New("object does not match schema")
+
+// SchemaValidatingExtractor decorates a NotificationExtractor to validate event
+// objects against a JSON schema before delegating, so malformed objects (e.g.,
+// custom resources created without schema validation) are rejected with a list
+// of violations rather than failing deep in the extractor. Delete events are not
+// validated, since only the name and namespace of their object can be relied on.
+type SchemaValidatingExtractor struct {
+	fwkdl.NotificationExtractor
+	validator validation.SchemaValidator
+}
+
+var _ fwkdl.NotificationExtractor = (*SchemaValidatingExtractor)(nil)
+
+// NewSchemaValidatingExtractor wraps ext to validate event objects against
+// schemaJSON, an OpenAPI v3 schema as used in CustomResourceDefinitions. Objects
+// are validated the way the API server validates custom resources.
+func NewSchemaValidatingExtractor(ext fwkdl.NotificationExtractor, schemaJSON []byte) (*SchemaValidatingExtractor, error) {
+	external := &apiextensionsv1.JSONSchemaProps{}
+	if err := json.Unmarshal(schemaJSON, external); err != nil {
+		return nil, fmt.Errorf("invalid schema for extractor %s: %w", ext.TypedName(), err)
+	}
+	schema := &apiextensions.JSONSchemaProps{}
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(external, schema, nil); err != nil {
+		return nil, fmt.Errorf("invalid schema for extractor %s: %w", ext.TypedName(), err)
+	}
+	validator, _, err := validation.NewSchemaValidator(schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema for extractor %s: %w", ext.TypedName(), err)
+	}
+	return &SchemaValidatingExtractor{NotificationExtractor: ext, validator: validator}, nil
+}
+
+// DependsOn implements fwkdl.DependentExtractor.
+func (s *SchemaValidatingExtractor) DependsOn() []string {
+	return dependsOnOf(s.NotificationExtractor)
+}
+
+// ExtractNotification validates the event object and invokes the wrapped
+// extractor if it matches the schema.
+func (s *SchemaValidatingExtractor) ExtractNotification(ctx context.Context, event fwkdl.NotificationEvent) error {
+	if event.Type != fwkdl.EventDelete && event.Object != nil {
+		if errs := validation.ValidateCustomResource(nil, event.Object.Object, s.validator); len(errs) > 0 {
+			return fmt.Errorf("%s %s/%s: %w: %w", event.Object.GetKind(), event.Namespace(), event.Name(),
+				ErrSchemaViolation, errs.ToAggregate())
+		}
+	}
+	return s.NotificationExtractor.ExtractNotification(ctx, event)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+)
+
+const poolSchema = `{
+	"type": "object",
+	"required": ["spec"],
+	"properties": {
+		"spec": {
+			"type": "object",
+			"required": ["targetPort"],
+			"properties": {
+				"targetPort": {"type": "integer", "minimum": 1},
+				"selector": {"type": "object", "additionalProperties": {"type": "string"}}
+			}
+		}
+	}
+}`
+
+func newPoolObject(spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetKind("InferencePool")
+	obj.SetNamespace("default")
+	obj.SetName("pool")
+	return obj
+}
+
+func TestSchemaValidatingExtractor(t *testing.T) {
+	inner := extractormocks.NewNotificationExtractor("pools")
+	ext, err := NewSchemaValidatingExtractor(inner, []byte(poolSchema))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	valid := newPoolObject(map[string]any{"targetPort": int64(8000), "selector": map[string]any{"app": "vllm"}})
+	require.NoError(t, ext.ExtractNotification(ctx, fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: valid}))
+	assert.Len(t, inner.GetEvents(), 1)
+
+	invalid := newPoolObject(map[string]any{"targetPort": "8000", "selector": map[string]any{"app": int64(1)}})
+	err = ext.ExtractNotification(ctx, fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: invalid})
+	require.ErrorIs(t, err, ErrSchemaViolation)
+	assert.Contains(t, err.Error(), "InferencePool default/pool")
+	assert.Contains(t, err.Error(), "spec.targetPort", "violations should be listed")
+	assert.Contains(t, err.Error(), "spec.selector.app", "all violations should be listed")
+	assert.Len(t, inner.GetEvents(), 1, "invalid objects should not reach the wrapped extractor")
+
+	deleted := &unstructured.Unstructured{}
+	deleted.SetName("pool")
+	require.NoError(t, ext.ExtractNotification(ctx, fwkdl.NotificationEvent{Type: fwkdl.EventDelete, Object: deleted}),
+		"delete events should not be validated")
+	assert.Len(t, inner.GetEvents(), 2)
+}
+
+func TestNewSchemaValidatingExtractorInvalidSchema(t *testing.T) {
+	_, err := NewSchemaValidatingExtractor(extractormocks.NewNotificationExtractor("pools"), []byte("{"))
+	assert.ErrorContains(t, err, "invalid schema")
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"cmp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// SourceSummary describes a notification source and its extractors, e.g., to
+// log the wiring of the datalayer on startup.
+type SourceSummary struct {
+	Source     fwkplugin.TypedName     `json:"source"`
+	GVK        schema.GroupVersionKind `json:"gvk"`
+	Extractors []fwkplugin.TypedName   `json:"extractors"` // in registration order
+}
+
+// DescribeSources summarizes the notification sources, ordered by source name,
+// with the extractors each was constructed with (see fwkdl.InitialExtractorsSource).
+func DescribeSources(sources []fwkdl.NotificationSource) []SourceSummary {
+	summaries := make([]SourceSummary, 0, len(sources))
+	for _, src := range sources {
+		var extractors []fwkdl.Extractor
+		if initial, ok := src.(fwkdl.InitialExtractorsSource); ok {
+			extractors = initial.InitialExtractors()
+		}
+		summaries = append(summaries, describeSource(src, extractors))
+	}
+	sortSummaries(summaries)
+	return summaries
+}
+
+// describeSource summarizes a notification source with the given extractors.
+func describeSource(src fwkdl.NotificationSource, extractors []fwkdl.Extractor) SourceSummary {
+	summary := SourceSummary{
+		Source:     src.TypedName(),
+		GVK:        src.GVK(),
+		Extractors: make([]fwkplugin.TypedName, len(extractors)),
+	}
+	for i, ext := range extractors {
+		summary.Extractors[i] = ext.TypedName()
+	}
+	return summary
+}
+
+func sortSummaries(summaries []SourceSummary) {
+	slices.SortFunc(summaries, func(a, b SourceSummary) int { return cmp.Compare(a.Source.Name, b.Source.Name) })
+}
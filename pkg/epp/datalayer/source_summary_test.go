@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
+)
+
+func TestDescribeSources(t *testing.T) {
+	deployGVK := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	first, second := extractormocks.NewNotificationExtractor("first"), extractormocks.NewNotificationExtractor("second")
+	pods := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "pods", podGVK,
+		notifications.WithExtractors(first, second))
+	deployments := notifications.NewK8sNotificationSource(notifications.NotificationSourceType, "deployments", deployGVK)
+	fakeSrc := NewFakeNotificationSource(t, podGVK, extractormocks.NewNotificationExtractor("bound"))
+
+	summaries := DescribeSources([]fwkdl.NotificationSource{pods, fakeSrc, deployments})
+	assert.Equal(t, []SourceSummary{
+		{Source: fakeSrc.TypedName(), GVK: podGVK, Extractors: []fwkplugin.TypedName{}},
+		{Source: deployments.TypedName(), GVK: deployGVK, Extractors: []fwkplugin.TypedName{}},
+		{Source: pods.TypedName(), GVK: podGVK, Extractors: []fwkplugin.TypedName{first.TypedName(), second.TypedName()}},
+	}, summaries, "sources should be ordered by name, with the extractors they were constructed with")
+
+	assert.Empty(t, DescribeSources(nil))
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// AuditRecord describes the processing of a single notification event.
+type AuditRecord struct {
+	// Type is the mutation type of the event.
+	Type EventType
+	// GVK is the kind of the event object.
+	GVK schema.GroupVersionKind
+	// Object identifies the event object.
+	Object types.NamespacedName
+	// NotifyErr is the error returned by the source's Notify, if any. Extractors
+	// are not invoked when it is set.
+	NotifyErr error
+	// Dropped is true when the source's Notify suppressed extractor dispatch.
+	Dropped bool
+	// Outcome summarizes the processing of the event.
+	Outcome DispatchOutcome
+	// Extractors lists the outcome of each extractor invocation, in call order.
+	Extractors []ExtractorOutcome
+	// Duration is the total processing time, including Notify.
+	Duration time.Duration
+}
+
+// ExtractorOutcome is the result of one extractor invocation.
+type ExtractorOutcome struct {
+	// Extractor identifies the extractor.
+	Extractor plugin.TypedName
+	// Err is the error returned by the extractor; nil on success. Use
+	// errors.Is(Err, ErrSkip) to tell skipped events from failures.
+	Err error
+	// Duration is the time spent in the extractor.
+	Duration time.Duration
+}
+
+// AuditSink receives a record for every notification event processed by the
+// framework core. It is independent of metrics and logging, and is called
+// synchronously, so implementations should not block.
+type AuditSink interface {
+	RecordDispatch(ctx context.Context, record AuditRecord)
+}
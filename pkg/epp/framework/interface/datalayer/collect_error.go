@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// CollectErrorKind classifies why collecting data from an endpoint failed.
+type CollectErrorKind int
+
+const (
+	// CollectErrorUnknown is used for errors that carry no classification.
+	CollectErrorUnknown CollectErrorKind = iota
+	// CollectErrorUnreachable means the endpoint could not be reached (e.g.,
+	// connection refused, DNS failure, timeout). Usually worth retrying later.
+	CollectErrorUnreachable
+	// CollectErrorBadResponse means the endpoint responded, but not with usable
+	// data (e.g., an unexpected HTTP status).
+	CollectErrorBadResponse
+	// CollectErrorParse means the endpoint returned data that could not be parsed.
+	// Retrying is unlikely to help until the endpoint or configuration changes.
+	CollectErrorParse
+)
+
+// String returns the kind's name, suitable for use as a log field or metric label.
+func (k CollectErrorKind) String() string {
+	switch k {
+	case CollectErrorUnreachable:
+		return "unreachable"
+	case CollectErrorBadResponse:
+		return "bad-response"
+	case CollectErrorParse:
+		return "parse"
+	default:
+		return "unknown"
+	}
+}
+
+// CollectError is a classified error returned by PollingDataSources. Callers can
+// use errors.As to retrieve the Kind and decide between retrying and skipping.
+// The error message is that of the wrapped error.
+type CollectError struct {
+	Kind CollectErrorKind
+	Err  error
+}
+
+// NewCollectError wraps err with the given kind. Returns nil if err is nil.
+func NewCollectError(kind CollectErrorKind, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &CollectError{Kind: kind, Err: err}
+}
+
+func (e *CollectError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CollectError) Unwrap() error {
+	return e.Err
+}
+
+// ClassifyCollectError returns the kind of a collection error. Errors wrapping a
+// CollectError report its Kind; unclassified network errors and deadline expiry
+// are reported as unreachable. All other errors are unknown.
+func ClassifyCollectError(err error) CollectErrorKind {
+	if err == nil {
+		return CollectErrorUnknown
+	}
+	var collectErr *CollectError
+	if errors.As(err, &collectErr) {
+		return collectErr.Kind
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+		return CollectErrorUnreachable
+	}
+	return CollectErrorUnknown
+}
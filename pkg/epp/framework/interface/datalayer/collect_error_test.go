@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyCollectError(t *testing.T) {
+	connErr := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	parseErr := errors.New("unexpected token")
+
+	tests := []struct {
+		name string
+		err  error
+		want CollectErrorKind
+	}{
+		{"nil", nil, CollectErrorUnknown},
+		{"plain error", errors.New("boom"), CollectErrorUnknown},
+		{"unclassified connection error", fmt.Errorf("fetch: %w", connErr), CollectErrorUnreachable},
+		{"deadline", context.DeadlineExceeded, CollectErrorUnreachable},
+		{"classified parse error", NewCollectError(CollectErrorParse, parseErr), CollectErrorParse},
+		{"wrapped classified error", fmt.Errorf("poll: %w", NewCollectError(CollectErrorBadResponse, parseErr)), CollectErrorBadResponse},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ClassifyCollectError(tt.err), tt.name)
+	}
+}
+
+func TestCollectError(t *testing.T) {
+	assert.NoError(t, NewCollectError(CollectErrorParse, nil))
+
+	inner := errors.New("unexpected token")
+	err := fmt.Errorf("poll failed: %w", NewCollectError(CollectErrorParse, inner))
+
+	var collectErr *CollectError
+	require.ErrorAs(t, err, &collectErr)
+	assert.Equal(t, CollectErrorParse, collectErr.Kind)
+	assert.ErrorIs(t, err, inner)
+	assert.Equal(t, "poll failed: unexpected token", err.Error(), "message should be that of the wrapped error")
+	assert.Equal(t, "parse", collectErr.Kind.String())
+}
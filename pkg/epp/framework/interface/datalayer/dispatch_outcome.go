@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+)
+
+// DispatchOutcome summarizes the processing of a notification event by the
+// extractors of its source, consistently across dispatch strategies, for logs,
+// metrics and audit records.
+type DispatchOutcome int
+
+const (
+	// DispatchSuccess is the outcome of events processed by at least one extractor,
+	// with no failures.
+	DispatchSuccess DispatchOutcome = iota
+	// DispatchPartialFailure is the outcome of events some extractors failed,
+	// while others processed them.
+	DispatchPartialFailure
+	// DispatchAllFailed is the outcome of events no extractor processed due to
+	// failures, including the source failing to process the event.
+	DispatchAllFailed
+	// DispatchSkipped is the outcome of events dropped by the source, or skipped
+	// by all extractors, including sources without extractors.
+	DispatchSkipped
+	// DispatchTimedOut is the outcome of events an extractor failed to process
+	// before its deadline, whatever the result of the other extractors.
+	DispatchTimedOut
+)
+
+// String returns the outcome name, for logs and metric labels.
+func (o DispatchOutcome) String() string {
+	switch o {
+	case DispatchSuccess:
+		return "success"
+	case DispatchPartialFailure:
+		return "partial-failure"
+	case DispatchAllFailed:
+		return "all-failed"
+	case DispatchSkipped:
+		return "skipped"
+	case DispatchTimedOut:
+		return "timed-out"
+	default:
+		return "unknown"
+	}
+}
+
+// OutcomeOf returns the outcome of a dispatch from the errors of its extractors,
+// as returned by a DispatchStrategy: nil on success, wrapping ErrSkip for
+// skipped events, and wrapping context.DeadlineExceeded for timeouts.
+func OutcomeOf(errs []error) DispatchOutcome {
+	succeeded, failed, timedOut := false, false, false
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded = true
+		case errors.Is(err, ErrSkip):
+		case errors.Is(err, context.DeadlineExceeded):
+			failed, timedOut = true, true
+		default:
+			failed = true
+		}
+	}
+	switch {
+	case timedOut:
+		return DispatchTimedOut
+	case failed && succeeded:
+		return DispatchPartialFailure
+	case failed:
+		return DispatchAllFailed
+	case succeeded:
+		return DispatchSuccess
+	default:
+		return DispatchSkipped
+	}
+}
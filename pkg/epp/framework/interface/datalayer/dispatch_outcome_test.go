@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutcomeOf(t *testing.T) {
+	failure := errors.New("extraction failed")
+	skip := fmt.Errorf("not relevant: %w", ErrSkip)
+	timeout := fmt.Errorf("slow: %w", context.DeadlineExceeded)
+
+	tests := []struct {
+		name string
+		errs []error
+		want DispatchOutcome
+	}{
+		{"no extractors", nil, DispatchSkipped},
+		{"all succeed", []error{nil, nil}, DispatchSuccess},
+		{"succeed and skip", []error{nil, skip}, DispatchSuccess},
+		{"all skip", []error{skip, skip}, DispatchSkipped},
+		{"some fail", []error{nil, failure}, DispatchPartialFailure},
+		{"all fail", []error{failure, failure}, DispatchAllFailed},
+		{"fail and skip", []error{failure, skip}, DispatchAllFailed},
+		{"timed out", []error{nil, timeout, failure}, DispatchTimedOut},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, OutcomeOf(tt.errs))
+		})
+	}
+	assert.Equal(t, "partial-failure", DispatchPartialFailure.String())
+}
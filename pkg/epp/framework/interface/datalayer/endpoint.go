@@ -18,6 +18,7 @@ package datalayer
 
 import (
 	"fmt"
+	"net"
 	"sync/atomic"
 )
 
@@ -41,6 +42,27 @@ type Endpoint interface {
 	EndpointMetricsState
 }
 
+// EndpointKey returns a stable identifier of the endpoint, for use as a cache key
+// and in logs and metrics: its namespace/name, as used by the Runtime to track
+// endpoints; or its address:port when the name is unset. It returns "" for nil
+// endpoints or endpoints without metadata.
+func EndpointKey(ep Endpoint) string {
+	if ep == nil {
+		return ""
+	}
+	meta := ep.GetMetadata()
+	switch {
+	case meta == nil:
+		return ""
+	case meta.NamespacedName.Name != "":
+		return meta.NamespacedName.String()
+	case meta.Port != "":
+		return net.JoinHostPort(meta.Address, meta.Port)
+	default:
+		return meta.Address
+	}
+}
+
 // ModelServer is an implementation of the Endpoint interface.
 type ModelServer struct {
 	pod        atomic.Pointer[EndpointMetadata]
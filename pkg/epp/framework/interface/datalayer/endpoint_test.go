@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestEndpointKey(t *testing.T) {
+	tests := []struct {
+		name string
+		ep   Endpoint
+		want string
+	}{
+		{name: "nil endpoint", ep: nil, want: ""},
+		{name: "namespaced name", want: "default/pod-a", ep: NewEndpoint(&EndpointMetadata{
+			NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod-a"}, Address: "10.0.0.1", Port: "8000"}, nil)},
+		{name: "address and port", want: "10.0.0.1:8000", ep: NewEndpoint(&EndpointMetadata{Address: "10.0.0.1", Port: "8000"}, nil)},
+		{name: "IPv6 address and port", want: "[fd00::1]:8000", ep: NewEndpoint(&EndpointMetadata{Address: "fd00::1", Port: "8000"}, nil)},
+		{name: "address only", want: "10.0.0.1", ep: NewEndpoint(&EndpointMetadata{Address: "10.0.0.1"}, nil)},
+		{name: "empty metadata", want: "", ep: NewEndpoint(nil, nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EndpointKey(tt.ep))
+		})
+	}
+
+	// keys are stable across calls and distinct across endpoints sharing an address.
+	a := NewEndpoint(&EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod-rank-0"}, Address: "10.0.0.1"}, nil)
+	b := NewEndpoint(&EndpointMetadata{NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod-rank-1"}, Address: "10.0.0.1"}, nil)
+	assert.Equal(t, EndpointKey(a), EndpointKey(a))
+	assert.NotEqual(t, EndpointKey(a), EndpointKey(b))
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+// EventPredicate reports whether a notification event should be processed, e.g.,
+// by matching the labels or namespace of its object. Predicates are composed
+// with And, Or and Not.
+type EventPredicate func(event NotificationEvent) bool
+
+// And returns a predicate matching events matched by all predicates, evaluated in
+// order until one doesn't match. With no predicates, it matches all events.
+func And(predicates ...EventPredicate) EventPredicate {
+	return func(event NotificationEvent) bool {
+		for _, p := range predicates {
+			if !p(event) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a predicate matching events matched by any of the predicates,
+// evaluated in order until one matches. With no predicates, it matches no event.
+func Or(predicates ...EventPredicate) EventPredicate {
+	return func(event NotificationEvent) bool {
+		for _, p := range predicates {
+			if p(event) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not returns a predicate matching the events p doesn't match.
+func Not(p EventPredicate) EventPredicate {
+	return func(event NotificationEvent) bool {
+		return !p(event)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestEventPredicates(t *testing.T) {
+	constant := func(v bool) EventPredicate {
+		return func(NotificationEvent) bool { return v }
+	}
+	isDelete := func(event NotificationEvent) bool { return event.Type == EventDelete }
+	inDefault := func(event NotificationEvent) bool { return event.Namespace() == "default" }
+
+	tests := []struct {
+		name string
+		p    EventPredicate
+		want bool
+	}{
+		{"and empty", And(), true},
+		{"and true true", And(constant(true), constant(true)), true},
+		{"and true false", And(constant(true), constant(false)), false},
+		{"and false true", And(constant(false), constant(true)), false},
+		{"and false false", And(constant(false), constant(false)), false},
+		{"or empty", Or(), false},
+		{"or true true", Or(constant(true), constant(true)), true},
+		{"or true false", Or(constant(true), constant(false)), true},
+		{"or false true", Or(constant(false), constant(true)), true},
+		{"or false false", Or(constant(false), constant(false)), false},
+		{"not true", Not(constant(true)), false},
+		{"not false", Not(constant(false)), true},
+		{"nested", Or(And(inDefault, Not(isDelete)), isDelete), true},
+		{"nested no match", And(inDefault, isDelete), false},
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("default")
+	event := NotificationEvent{Type: EventAddOrUpdate, Object: obj}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.p(event))
+		})
+	}
+}
+
+func TestEventPredicatesShortCircuit(t *testing.T) {
+	calls := 0
+	counted := func(v bool) EventPredicate {
+		return func(NotificationEvent) bool { calls++; return v }
+	}
+
+	assert.False(t, And(counted(false), counted(true))(NotificationEvent{}))
+	assert.Equal(t, 1, calls, "And should stop at the first mismatch")
+	calls = 0
+	assert.True(t, Or(counted(true), counted(false))(NotificationEvent{}))
+	assert.Equal(t, 1, calls, "Or should stop at the first match")
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"sync"
+)
+
+// EventScratch holds values passed between the extractors of a single event,
+// e.g., a score derived by one extractor and consumed by a later one, without
+// resorting to global state. Values are dropped once the event is dispatched.
+// Extractors reading a value should depend on its writer (see
+// DependentExtractor) so that they run after it. It is safe for concurrent use.
+type EventScratch struct {
+	mu     sync.RWMutex
+	values map[string]any
+}
+
+// Store sets the value of key, replacing any previous value. Keys should be
+// prefixed by the name of the writing extractor to avoid collisions.
+func (s *EventScratch) Store(key string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.values == nil {
+		s.values = make(map[string]any)
+	}
+	s.values[key] = value
+}
+
+// Load returns the value of key, and whether it was set.
+func (s *EventScratch) Load(key string) (any, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.values[key]
+	return value, ok
+}
+
+type eventScratchKey struct{}
+
+// WithEventScratch returns a context carrying a new, empty EventScratch. The
+// framework core sets it on the context passed to the extractors of each event.
+func WithEventScratch(ctx context.Context) context.Context {
+	return context.WithValue(ctx, eventScratchKey{}, &EventScratch{})
+}
+
+// EventScratchFrom returns the EventScratch of ctx, or nil outside the dispatch
+// of an event (see WithEventScratch).
+func EventScratchFrom(ctx context.Context) *EventScratch {
+	scratch, _ := ctx.Value(eventScratchKey{}).(*EventScratch)
+	return scratch
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventScratch(t *testing.T) {
+	assert.Nil(t, EventScratchFrom(context.Background()), "no scratch outside of a dispatch")
+
+	ctx := WithEventScratch(context.Background())
+	scratch := EventScratchFrom(ctx)
+	require.NotNil(t, scratch)
+	_, ok := scratch.Load("a/score")
+	assert.False(t, ok)
+
+	scratch.Store("a/score", 1)
+	scratch.Store("a/score", 2)
+	value, ok := scratch.Load("a/score")
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.NotSame(t, scratch, EventScratchFrom(WithEventScratch(ctx)), "each event should get its own scratch")
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"errors"
+	"fmt"
+)
+
+// LogValuesError annotates an extractor error with log fields, e.g., the name of
+// the metric or field that failed to parse. The framework core includes them,
+// under the extractor's name, in the log line reporting the failures of an event.
+// The error message is that of the wrapped error.
+type LogValuesError struct {
+	Err           error
+	KeysAndValues []any // alternating keys and values, as taken by logr
+}
+
+// WithLogValues annotates err with the given log fields. Returns nil if err is nil.
+func WithLogValues(err error, keysAndValues ...any) error {
+	if err == nil {
+		return nil
+	}
+	return &LogValuesError{Err: err, KeysAndValues: keysAndValues}
+}
+
+func (e *LogValuesError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *LogValuesError) Unwrap() error {
+	return e.Err
+}
+
+// LogValues returns the log fields err was annotated with, as a map keyed by
+// field name, or nil if it carries none. Fields of outer annotations take
+// precedence over those of errors they wrap. A trailing key without a value is
+// reported with a nil value.
+func LogValues(err error) map[string]any {
+	var fields map[string]any
+	for err != nil {
+		var annotated *LogValuesError
+		if !errors.As(err, &annotated) {
+			break
+		}
+		if fields == nil {
+			fields = make(map[string]any, (len(annotated.KeysAndValues)+1)/2)
+		}
+		for i := 0; i < len(annotated.KeysAndValues); i += 2 {
+			key := fmt.Sprint(annotated.KeysAndValues[i])
+			if _, ok := fields[key]; ok {
+				continue
+			}
+			var value any
+			if i+1 < len(annotated.KeysAndValues) {
+				value = annotated.KeysAndValues[i+1]
+			}
+			fields[key] = value
+		}
+		err = annotated.Err
+	}
+	return fields
+}
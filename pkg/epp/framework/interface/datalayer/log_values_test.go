@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogValues(t *testing.T) {
+	base := errors.New("bad value")
+
+	assert.Nil(t, WithLogValues(nil, "field", "x"))
+	assert.Nil(t, LogValues(base))
+	assert.Nil(t, LogValues(nil))
+
+	annotated := WithLogValues(base, "field", "spec.replicas", "value", -1)
+	assert.Equal(t, base.Error(), annotated.Error())
+	assert.ErrorIs(t, annotated, base)
+	assert.Equal(t, map[string]any{"field": "spec.replicas", "value": -1}, LogValues(annotated))
+
+	wrapped := WithLogValues(fmt.Errorf("parse: %w", annotated), "field", "status", "dangling")
+	assert.Equal(t, map[string]any{"field": "status", "value": -1, "dangling": nil}, LogValues(wrapped),
+		"outer fields should take precedence")
+}
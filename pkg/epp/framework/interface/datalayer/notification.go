@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// ErrReentrantNotify is returned by the framework core when a source is notified
+// from within the dispatch of one of its own events, e.g., by an extractor whose work triggers a
+// cache callback feeding the same source. Processing such events inline risks
+// unbounded recursion, and deadlocks once dispatch takes locks.
+var ErrReentrantNotify = errors.New("re-entrant notification")
+
+type dispatchingKey struct{}
+
+// WithDispatching returns a context marking that events of the named source are
+// being dispatched to its extractors. The framework core sets it on the context
+// passed to extractors.
+func WithDispatching(ctx context.Context, source plugin.TypedName) context.Context {
+	parent, _ := ctx.Value(dispatchingKey{}).(*dispatchingSource)
+	return context.WithValue(ctx, dispatchingKey{}, &dispatchingSource{name: source, parent: parent})
+}
+
+// IsDispatching reports whether ctx descends from the dispatch of an event of the
+// named source (see WithDispatching).
+func IsDispatching(ctx context.Context, source plugin.TypedName) bool {
+	for d, _ := ctx.Value(dispatchingKey{}).(*dispatchingSource); d != nil; d = d.parent {
+		if d.name == source {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatchingSource is a stack of the sources being dispatched in a context.
+type dispatchingSource struct {
+	name   plugin.TypedName
+	parent *dispatchingSource
+}
+
+type dryRunKey struct{}
+
+// WithDryRun returns a context marking a dry-run dispatch: extractors should
+// consult IsDryRun and skip their side effects, e.g., writing endpoint
+// attributes, logging what they would have done instead. The framework core sets
+// it for events whose Meta.DryRun is set.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether ctx descends from a dry-run dispatch (see WithDryRun).
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+type eventHoldKey struct{}
+
+// eventHold is the completion of the event being notified, which Notify may take
+// over with HoldEvent.
+type eventHold struct {
+	release func()
+	held    atomic.Bool
+}
+
+// WithEventHold returns a context on which the source notified of an event can
+// hold it back with HoldEvent, and a function reporting whether it did. release
+// completes the event, e.g., marks it processed in the source's Journal: the
+// framework core calls it once the event is dispatched, unless it was held back.
+func WithEventHold(ctx context.Context, release func()) (context.Context, func() bool) {
+	hold := &eventHold{release: release}
+	return context.WithValue(ctx, eventHoldKey{}, hold), hold.held.Load
+}
+
+// HoldEvent is called by Notify when the source holds the event back for a later
+// redelivery, e.g., while paused. It returns the function completing the event,
+// which the source must call once the event is redelivered or dropped, so that a
+// restart meanwhile replays it. The function is a no-op if the core does not
+// track the completion of the event (see WithEventHold).
+func HoldEvent(ctx context.Context) (release func()) {
+	hold, _ := ctx.Value(eventHoldKey{}).(*eventHold)
+	if hold == nil || hold.held.Swap(true) {
+		return func() {}
+	}
+	return hold.release
+}
+
+// Namespace returns the namespace of the event object, or "" if Object is nil.
+func (e NotificationEvent) Namespace() string {
+	if e.Object == nil {
+		return ""
+	}
+	return e.Object.GetNamespace()
+}
+
+// Name returns the name of the event object, or "" if Object is nil.
+func (e NotificationEvent) Name() string {
+	if e.Object == nil {
+		return ""
+	}
+	return e.Object.GetName()
+}
+
+// UID returns the UID of the event object, or "" if Object is nil.
+// Note that UIDs are not guaranteed to be set on delete notifications.
+func (e NotificationEvent) UID() types.UID {
+	if e.Object == nil {
+		return ""
+	}
+	return e.Object.GetUID()
+}
+
+// ResourceVersion returns the resource version of the event object, or "" if
+// Object is nil.
+func (e NotificationEvent) ResourceVersion() string {
+	if e.Object == nil {
+		return ""
+	}
+	return e.Object.GetResourceVersion()
+}
+
+// TypedObject returns the decoded event object as T (e.g., *corev1.Pod). The
+// boolean is false if the source did not decode the object or decoded it into a
+// different type.
+func TypedObject[T runtime.Object](e NotificationEvent) (T, bool) {
+	typed, ok := e.Typed.(T)
+	return typed, ok
+}
+
+// ExpectKind returns an error if the event object is not of the given GVK.
+// Extractors can call it at the top of ExtractNotification to fail fast on
+// misrouted events, instead of silently mis-parsing the object.
+func ExpectKind(e NotificationEvent, gvk schema.GroupVersionKind) error {
+	if e.Object == nil {
+		return fmt.Errorf("expected %s object, got nil", gvk)
+	}
+	if got := e.Object.GroupVersionKind(); got != gvk {
+		return fmt.Errorf("expected %s object, got %s %s/%s", gvk, got, e.Namespace(), e.Name())
+	}
+	return nil
+}
+
+// ResolveTombstone returns the last known state of an object delivered by an
+// informer delete handler, unwrapping cache.DeletedFinalStateUnknown tombstones
+// and converting typed objects to unstructured. It returns an error naming the
+// object key when the state cannot be recovered.
+//
+// The framework core reads objects through controller-runtime, which already
+// unwraps tombstones; this helper is for sources handling raw informer events.
+func ResolveTombstone(obj any) (*unstructured.Unstructured, error) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		if tombstone.Obj == nil {
+			return nil, fmt.Errorf("tombstone for %s has no object", tombstone.Key)
+		}
+		resolved, err := ResolveTombstone(tombstone.Obj)
+		if err != nil {
+			return nil, fmt.Errorf("tombstone for %s: %w", tombstone.Key, err)
+		}
+		return resolved, nil
+	}
+
+	switch o := obj.(type) {
+	case *unstructured.Unstructured:
+		return o, nil
+	case runtime.Object:
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %T to unstructured: %w", o, err)
+		}
+		return &unstructured.Unstructured{Object: content}, nil
+	default:
+		return nil, fmt.Errorf("unexpected deleted object type %T", obj)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+)
+
+func TestNotificationEventAccessors(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("ns")
+	obj.SetName("obj")
+	obj.SetUID(types.UID("uid-1"))
+	obj.SetResourceVersion("42")
+
+	event := NotificationEvent{Type: EventAddOrUpdate, Object: obj}
+	assert.Equal(t, "ns", event.Namespace())
+	assert.Equal(t, "obj", event.Name())
+	assert.Equal(t, types.UID("uid-1"), event.UID())
+	assert.Equal(t, "42", event.ResourceVersion())
+}
+
+func TestNotificationEventAccessorsNilObject(t *testing.T) {
+	event := NotificationEvent{Type: EventDelete}
+	assert.NotPanics(t, func() {
+		assert.Empty(t, event.Namespace())
+		assert.Empty(t, event.Name())
+		assert.Empty(t, event.UID())
+		assert.Empty(t, event.ResourceVersion())
+	})
+}
+
+func TestExpectKind(t *testing.T) {
+	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"})
+	obj.SetNamespace("ns")
+	obj.SetName("cm")
+
+	assert.Error(t, ExpectKind(NotificationEvent{Type: EventDelete}, podGVK), "nil object")
+
+	err := ExpectKind(NotificationEvent{Type: EventAddOrUpdate, Object: obj}, podGVK)
+	assert.EqualError(t, err, "expected /v1, Kind=Pod object, got /v1, Kind=ConfigMap ns/cm")
+
+	obj.SetGroupVersionKind(podGVK)
+	assert.NoError(t, ExpectKind(NotificationEvent{Type: EventAddOrUpdate, Object: obj}, podGVK))
+}
+
+func TestResolveTombstone(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("ns")
+	obj.SetName("obj")
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod"}}
+
+	tests := []struct {
+		name     string
+		obj      any
+		wantName string
+		wantErr  string
+	}{
+		{name: "unstructured object", obj: obj, wantName: "obj"},
+		{name: "unstructured tombstone", obj: toolscache.DeletedFinalStateUnknown{Key: "ns/obj", Obj: obj}, wantName: "obj"},
+		{name: "typed tombstone", obj: toolscache.DeletedFinalStateUnknown{Key: "ns/pod", Obj: pod}, wantName: "pod"},
+		{name: "empty tombstone", obj: toolscache.DeletedFinalStateUnknown{Key: "ns/gone"}, wantErr: "tombstone for ns/gone has no object"},
+		{name: "unknown type", obj: "ns/obj", wantErr: "unexpected deleted object type string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveTombstone(tt.obj)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "ns", got.GetNamespace())
+			assert.Equal(t, tt.wantName, got.GetName())
+
+			event := NotificationEvent{Type: EventDelete, Object: got}
+			assert.Equal(t, tt.wantName, event.Name())
+		})
+	}
+}
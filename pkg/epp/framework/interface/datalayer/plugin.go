@@ -18,9 +18,13 @@ package datalayer
 
 import (
 	"context"
+	"errors"
 	"reflect"
+	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
@@ -56,6 +60,21 @@ type PollingDataSource interface {
 	Poll(ctx context.Context, ep Endpoint) (any, error)
 }
 
+// ErrSkip can be returned (optionally wrapped) by an Extractor to signal that it
+// deliberately ignored the data or event, or by a PollingDataSource that
+// deliberately did not poll the endpoint. The Runtime does not treat it as a
+// failure and does not log it as an error.
+var ErrSkip = errors.New("extractor skipped")
+
+// PollTimeoutSource is an optional interface for PollingDataSources whose poll
+// deadline depends on the endpoint, e.g., to give slow model servers more time
+// without penalizing fast ones.
+type PollTimeoutSource interface {
+	// PollTimeout returns the deadline for polling the endpoint. Values <= 0 use
+	// the Runtime's default.
+	PollTimeout(ep Endpoint) time.Duration
+}
+
 // Extractor transforms raw data into structured attributes.
 type Extractor interface {
 	plugin.Plugin
@@ -66,6 +85,24 @@ type Extractor interface {
 	Extract(ctx context.Context, data any, ep Endpoint) error
 }
 
+// DependentExtractor is an optional interface for Extractors that must run after
+// other extractors attached to the same DataSource. Dependencies are referenced by
+// extractor plugin name (TypedName().Name). The Runtime orders extractors so that
+// dependencies run first, and rejects unknown dependencies and cycles when the
+// configuration is loaded.
+type DependentExtractor interface {
+	// DependsOn returns the names of the extractors that must run before this one.
+	DependsOn() []string
+}
+
+// InitialExtractorsSource is an optional interface for DataSources constructed
+// with their extractors. The Runtime registers them when the configuration is
+// loaded, ahead of any extractors configured for the source.
+type InitialExtractorsSource interface {
+	// InitialExtractors returns the extractors the source was constructed with.
+	InitialExtractors() []Extractor
+}
+
 // ValidatingDataSource is an optional interface that DataSources can implement
 // to perform additional custom validation when adding extractors.
 type ValidatingDataSource interface {
@@ -84,8 +121,21 @@ const (
 	EventDelete
 )
 
+// String returns the event type name, for logs and trace attributes.
+func (t EventType) String() string {
+	switch t {
+	case EventAddOrUpdate:
+		return "add-or-update"
+	case EventDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
 // NotificationEvent carries the event type and the affected object.
-// Object is deep-copied by the framework core before delivery.
+// Object is deep-copied by the framework core before delivery, unless the
+// source opted out via DeepCopyOptOutSource.
 type NotificationEvent struct {
 	// Type is the mutation type.
 	Type EventType
@@ -93,6 +143,34 @@ type NotificationEvent struct {
 	// last known state (for delete). Note that for delete notifications
 	// only the object's name and namespace can be relied on.
 	Object *unstructured.Unstructured
+	// Typed is Object decoded into a typed API object, for sources configured
+	// with a decoder; nil otherwise. It is decoded once per event and shared by
+	// all extractors, which must treat it as read-only. See TypedObject.
+	Typed runtime.Object
+	// Meta describes how the framework core received the event. It is zero-valued
+	// when unavailable, e.g., for events not delivered by an informer.
+	Meta EventMeta
+}
+
+// EventMeta is informer delivery metadata of a NotificationEvent, for extractors
+// adapting their behavior to the event backlog. When the same object changes
+// several times before its event is processed, the changes are coalesced into a
+// single event and Meta describes the first of them.
+type EventMeta struct {
+	// Sync is true for events triggered by the informer's initial list, rather
+	// than by a watch.
+	Sync bool
+	// EnqueueTime is when the core queued the event for processing; zero if unknown.
+	EnqueueTime time.Time
+	// QueueLength is the number of events of the source waiting to be processed
+	// when this one was queued.
+	QueueLength int
+	// Replayed is true for events replayed from the source's Journal, i.e., that
+	// were received but not fully processed before a restart.
+	Replayed bool
+	// DryRun is set by sources dispatching the event in dry-run mode, so that the
+	// extractors see a context marked with WithDryRun.
+	DryRun bool
 }
 
 // NotificationSource is an event-driven DataSource for a single k8s GVK.
@@ -110,6 +188,225 @@ type NotificationSource interface {
 	Notify(ctx context.Context, event NotificationEvent) (*NotificationEvent, error)
 }
 
+// DeepCopyOptOutSource is an optional interface NotificationSources can implement
+// to ask the framework core to skip deep-copying event objects before delivery.
+//
+// WARNING: when the copy is skipped, the source and its extractor receive the
+// object held by the shared informer cache. Any mutation corrupts the cache for
+// every other reader. The core only honors the request when at most one extractor
+// is bound to the source, and that extractor must treat the object as read-only.
+type DeepCopyOptOutSource interface {
+	// SkipDeepCopy reports whether the source opted out of deep-copying.
+	SkipDeepCopy() bool
+}
+
+// CopyFunc returns an isolated copy of an event object, sharing no memory with it.
+// It may leave out fields the extractors don't need, to cut the cost of copying
+// large objects.
+type CopyFunc func(*unstructured.Unstructured) *unstructured.Unstructured
+
+// CopyFuncSource is an optional interface for NotificationSources that replace
+// the deep-copy of event objects by the framework core with their own copy. The
+// copy function is handed the object held by the informer cache, so it makes the
+// only copy of each event. It takes precedence over DeepCopyOptOutSource.
+type CopyFuncSource interface {
+	// CopyFunc returns the copy function, or nil for the default deep-copy.
+	CopyFunc() CopyFunc
+}
+
+// AckFunc is called with an event once all extractors processed it successfully,
+// i.e., none failed, e.g., to advance a checkpoint in an external system. The
+// outcome is DispatchSuccess, or DispatchSkipped if every extractor skipped it.
+type AckFunc func(event NotificationEvent, outcome DispatchOutcome)
+
+// SyncAwareSource is an optional interface for NotificationSources that need to
+// know when the framework core completed the initial list of the watched GVK.
+// Until then, the absence of an object does not imply it was deleted.
+type SyncAwareSource interface {
+	// SetSynced is called by the core once the initial list has been delivered.
+	SetSynced()
+	// HasSynced reports whether SetSynced has been called.
+	HasSynced() bool
+}
+
+// FairDispatchSource is an optional interface for NotificationSources that want
+// the framework core to reorder their extractors by observed latency, so that a
+// consistently slow extractor does not delay the others on every event. Declared
+// dependencies (DependentExtractor) are always respected.
+type FairDispatchSource interface {
+	// FairDispatch reports whether latency-based reordering is enabled.
+	FairDispatch() bool
+}
+
+// DispatchStrategy runs the extractors of a NotificationSource on an event, e.g.,
+// sequentially or concurrently. The extractors are passed in the order the
+// framework core would run them sequentially, which respects their dependencies.
+// They are instrumented by the core, so their latency, deadline and audit outcome
+// are tracked however they run. Dispatch returns one error per extractor, in
+// order, nil for those that succeeded or were not invoked.
+type DispatchStrategy interface {
+	Dispatch(ctx context.Context, event NotificationEvent, extractors []NotificationExtractor) []error
+}
+
+// SequentialDispatch runs the extractors one after the other, in order. It is the
+// default DispatchStrategy.
+var SequentialDispatch DispatchStrategy = sequentialDispatch{}
+
+type sequentialDispatch struct{}
+
+func (sequentialDispatch) Dispatch(ctx context.Context, event NotificationEvent, extractors []NotificationExtractor) []error {
+	errs := make([]error, len(extractors))
+	for i, ext := range extractors {
+		errs[i] = ext.ExtractNotification(ctx, event)
+	}
+	return errs
+}
+
+// ExtractorToggleSource is an optional interface for NotificationSources whose
+// extractors can be disabled at runtime without being removed, e.g., during an
+// incident. The framework core checks it before invoking each extractor on an
+// event, and reports disabled extractors as skipped.
+type ExtractorToggleSource interface {
+	// SkipExtractor reports whether the named extractor is disabled, counting the
+	// skipped invocation if so.
+	SkipExtractor(name string) bool
+}
+
+// Journal persists the events of a NotificationSource until they are processed,
+// so that events interrupted by a crash are replayed on restart. Implementations
+// must be safe for concurrent use.
+type Journal interface {
+	// Append records the event before it is processed. The returned function is
+	// called once it has been processed, after which it must not be replayed.
+	Append(event NotificationEvent) (done func() error, err error)
+	// Replay calls fn, in the order they were appended, with the events not marked
+	// processed, then forgets those for which fn succeeded.
+	Replay(fn func(event NotificationEvent) error) error
+}
+
+// JournalingSource is an optional interface for NotificationSources journaling
+// their events. The framework core replays the journal once the manager started
+// and the informer of the source synced, before delivering live events, with
+// EventMeta.Replayed set.
+type JournalingSource interface {
+	// Journal returns the journal, or nil to disable journaling.
+	Journal() Journal
+}
+
+// WatchOptionsSource is an optional interface for NotificationSources that watch
+// only some of the objects of their GVK (see WatchOptions).
+type WatchOptionsSource interface {
+	// WatchOptions returns the effective options of the source.
+	WatchOptions() WatchOptions
+}
+
+// DispatchOptions configure how the framework core dispatches the events of a
+// NotificationSource to its extractors. The zero value keeps the core defaults.
+type DispatchOptions struct {
+	// Strategy runs the extractors on each event; nil for SequentialDispatch.
+	Strategy DispatchStrategy
+	// ExtractorDeadline bounds every extractor invocation; values <= 0 disable the
+	// deadline. The core cancels the extractor context once the deadline passes,
+	// and logs extractors that do not honor the cancellation, as a diagnostic aid
+	// for plugin authors.
+	ExtractorDeadline time.Duration
+	// Ack acknowledges the events processed successfully, or nil. Events dropped
+	// by the source or failed by an extractor are not acknowledged.
+	Ack AckFunc
+	// Audit receives a record of every event processed, or nil.
+	Audit AuditSink
+}
+
+// DispatchOptionsSource is an optional interface for NotificationSources that
+// configure the dispatch of their events.
+type DispatchOptionsSource interface {
+	// DispatchOptions returns the options of the source.
+	DispatchOptions() DispatchOptions
+}
+
+// UnboundWarningSource is an optional interface for NotificationSources that want
+// the framework core to warn, rate-limited, when it notifies them of events while
+// no extractor is bound to them, which usually points to extractors that failed
+// to attach.
+type UnboundWarningSource interface {
+	// WarnWithoutExtractors reports whether the warning is enabled.
+	WarnWithoutExtractors() bool
+}
+
+// RedeliverySource is an optional interface for NotificationSources that hold
+// events back and deliver them later, e.g., while paused, or that receive events
+// forwarded from other sources. The framework core
+// calls SetRedeliver when binding the source, with a function processing an
+// event as the core processes those it receives: via Notify, then the extractors.
+type RedeliverySource interface {
+	// SetRedeliver sets the function redelivering held back events.
+	SetRedeliver(redeliver func(ctx context.Context, event NotificationEvent) error)
+}
+
+// DrainableSource is an optional interface for NotificationSources that drain
+// their backlog on shutdown. The framework core calls SetAwaitDispatches when
+// binding the source, with a function waiting for the events of the source being
+// processed, including those whose extractors a dispatch strategy detached, until
+// none is left or ctx is done, and returning the number left.
+type DrainableSource interface {
+	// SetAwaitDispatches sets the function awaiting the events being processed.
+	SetAwaitDispatches(await func(ctx context.Context) int)
+}
+
+// IndexFunc returns the keys an object is indexed under, e.g., the value of one
+// of its labels; nil for none.
+type IndexFunc func(obj *unstructured.Unstructured) []string
+
+// LookupFunc returns the objects indexed under key by the named index, read from
+// the informer cache.
+type LookupFunc func(ctx context.Context, index, key string) ([]*unstructured.Unstructured, error)
+
+// IndexingSource is an optional interface for NotificationSources that index the
+// objects of their GVK in the informer cache, so that their extractors can look
+// related objects up instead of scanning them. The framework core registers the
+// indexes and calls SetLookup when binding the source.
+type IndexingSource interface {
+	// Indexes returns the index functions, by index name.
+	Indexes() map[string]IndexFunc
+	// SetLookup sets the function looking objects up by index.
+	SetLookup(lookup LookupFunc)
+}
+
+// ResyncSource is an optional interface for NotificationSources that want the
+// objects of their GVK periodically delivered again, unchanged, e.g., so that
+// extractors recover from state they lost. Resynced objects are delivered as
+// EventAddOrUpdate events.
+type ResyncSource interface {
+	// ResyncPeriod returns the interval between resyncs; values <= 0 disable them.
+	ResyncPeriod() time.Duration
+}
+
+// HeartbeatSource is an optional interface for NotificationSources that want the
+// framework core to periodically log, at debug level, which extractor is still
+// processing an event, to help find extractors stuck in dispatch.
+type HeartbeatSource interface {
+	// ExtractorHeartbeat returns the interval between heartbeat logs; values <= 0
+	// disable them.
+	ExtractorHeartbeat() time.Duration
+}
+
+// ErrorLimitSource is an optional interface for NotificationSources that want to
+// bound how many extractor errors the framework core includes in the log line
+// reporting the failures of an event. Further errors are summarized by count.
+type ErrorLimitSource interface {
+	// MaxLoggedErrors returns the number of errors to include; values <= 0 use
+	// the core's default.
+	MaxLoggedErrors() int
+}
+
+// TracingSource is an optional interface for NotificationSources that want the
+// framework core to trace the processing of their events. The core starts a span
+// per extractor invocation using the returned tracer; a nil tracer disables tracing.
+type TracingSource interface {
+	// Tracer returns the tracer used for extractor spans, or nil.
+	Tracer() trace.Tracer
+}
+
 // NotificationExtractor processes k8s object events pushed from a
 // NotificationSource.
 type NotificationExtractor interface {
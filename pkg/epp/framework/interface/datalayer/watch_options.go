@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+)
+
+// WatchOptions scopes the objects of a NotificationSource. The framework core
+// lists, watches and caches only the objects in Namespaces matching LabelSelector,
+// in an informer cache of the source, and the source applies the options again
+// before dispatching, so that the objects watched and the events dispatched can't
+// drift apart.
+type WatchOptions struct {
+	Namespaces    []string           // empty watches all namespaces
+	LabelSelector k8slabels.Selector // nil selects all objects
+	Filter        EventPredicate     // applied before dispatching only; nil accepts all events
+}
+
+// Selects reports whether the object is in the namespaces and matches the label
+// selector of the options.
+func (o WatchOptions) Selects(obj metav1.Object) bool {
+	if len(o.Namespaces) > 0 && !slices.Contains(o.Namespaces, obj.GetNamespace()) {
+		return false
+	}
+	return o.LabelSelector == nil || o.LabelSelector.Matches(k8slabels.Set(obj.GetLabels()))
+}
+
+// Matches reports whether the event should be dispatched: its object is selected
+// and the event matches the filter. The labels of deleted objects are not known,
+// so delete events are only matched by namespace.
+func (o WatchOptions) Matches(event NotificationEvent) bool {
+	if event.Object != nil {
+		selected := o
+		if event.Type == EventDelete {
+			selected.LabelSelector = nil
+		}
+		if !selected.Selects(event.Object) {
+			return false
+		}
+	}
+	return o.Filter == nil || o.Filter(event)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+)
+
+func TestWatchOptionsMatches(t *testing.T) {
+	opts := WatchOptions{
+		Namespaces:    []string{"default"},
+		LabelSelector: k8slabels.SelectorFromSet(k8slabels.Set{"app": "vllm"}),
+		Filter:        func(event NotificationEvent) bool { return event.Name() != "filtered" },
+	}
+	newEvent := func(eventType EventType, namespace, name string, objLabels map[string]string) NotificationEvent {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		obj.SetLabels(objLabels)
+		return NotificationEvent{Type: eventType, Object: obj}
+	}
+	vllm := map[string]string{"app": "vllm"}
+
+	tests := []struct {
+		name  string
+		event NotificationEvent
+		want  bool
+	}{
+		{"selected", newEvent(EventAddOrUpdate, "default", "pod", vllm), true},
+		{"other namespace", newEvent(EventAddOrUpdate, "other", "pod", vllm), false},
+		{"other labels", newEvent(EventAddOrUpdate, "default", "pod", map[string]string{"app": "other"}), false},
+		{"filtered", newEvent(EventAddOrUpdate, "default", "filtered", vllm), false},
+		{"delete without labels", newEvent(EventDelete, "default", "pod", nil), true},
+		{"delete in other namespace", newEvent(EventDelete, "other", "pod", nil), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, opts.Matches(tt.event))
+		})
+	}
+
+	assert.True(t, WatchOptions{}.Matches(newEvent(EventAddOrUpdate, "any", "pod", nil)), "zero options should match all events")
+}
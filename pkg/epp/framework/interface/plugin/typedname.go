@@ -16,6 +16,11 @@ limitations under the License.
 
 package plugin
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
 	separator = "/"
 )
@@ -30,5 +35,25 @@ type TypedName struct {
 
 // String returns the type and name rendered as "<name>/<type>".
 func (tn TypedName) String() string {
-	return tn.Name + separator + tn.Type
+	return tn.StringSep(separator)
+}
+
+// StringSep returns the type and name rendered as "<name><sep><type>", for callers
+// whose identifiers can't contain the default separator (e.g., metric labels).
+func (tn TypedName) StringSep(sep string) string {
+	return tn.Name + sep + tn.Type
+}
+
+// ParseTypedName parses a string produced by StringSep with the same separator
+// (use "/" for String). The string is split at the last occurrence of sep, so
+// plugin types must not contain it.
+func ParseTypedName(s, sep string) (TypedName, error) {
+	if sep == "" {
+		return TypedName{}, fmt.Errorf("empty separator parsing typed name %q", s)
+	}
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return TypedName{}, fmt.Errorf("typed name %q does not contain separator %q", s, sep)
+	}
+	return TypedName{Name: s[:i], Type: s[i+len(sep):]}, nil
 }
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedNameStringSep(t *testing.T) {
+	tn := TypedName{Type: "prefix-cache-scorer", Name: "my.scorer"}
+
+	assert.Equal(t, "my.scorer/prefix-cache-scorer", tn.String(), "default separator must be unchanged")
+	assert.Equal(t, tn.String(), tn.StringSep(separator))
+	assert.Equal(t, "my.scorer__prefix-cache-scorer", tn.StringSep("__"))
+
+	for _, sep := range []string{separator, "__", ":"} {
+		got, err := ParseTypedName(tn.StringSep(sep), sep)
+		require.NoError(t, err)
+		assert.Equal(t, tn, got, "round-trip with separator %q", sep)
+	}
+}
+
+func TestParseTypedNameErrors(t *testing.T) {
+	_, err := ParseTypedName("name/type", "__")
+	assert.Error(t, err, "missing separator")
+
+	_, err = ParseTypedName("name/type", "")
+	assert.Error(t, err, "empty separator")
+}
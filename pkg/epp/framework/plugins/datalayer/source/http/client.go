@@ -25,6 +25,8 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 )
 
 // Client is an interface for retrieving the data from an endpoint URL.
@@ -81,15 +83,21 @@ func (cl *client) Get(ctx context.Context, target *url.URL, ep Addressable,
 	}
 	resp, err := defaultClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch data from %s: %w", ep.GetNamespacedName(), err)
+		return nil, fwkdl.NewCollectError(fwkdl.CollectErrorUnreachable,
+			fmt.Errorf("failed to fetch data from %s: %w", ep.GetNamespacedName(), err))
 	}
 	defer func() {
 		_ = resp.Body.Close()
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code from %s: %v", ep.GetNamespacedName(), resp.StatusCode)
+		return nil, fwkdl.NewCollectError(fwkdl.CollectErrorBadResponse,
+			fmt.Errorf("unexpected status code from %s: %v", ep.GetNamespacedName(), resp.StatusCode))
 	}
 
-	return parser(resp.Body)
+	data, err := parser(resp.Body)
+	if err != nil {
+		return nil, fwkdl.NewCollectError(fwkdl.CollectErrorParse, err)
+	}
+	return data, nil
 }
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// drainState stops a source from accepting new events once it is drained.
+type drainState struct {
+	draining atomic.Bool
+	mu       sync.Mutex
+	await    func(ctx context.Context) int // set by the core; nil until bound
+}
+
+// Drain stops the delivery of new events, e.g., on shutdown, and processes the
+// backlog of the source: the events buffered while paused are redelivered, then
+// Drain waits for the events being processed, including those whose extractors
+// a CancellableDispatch detached. It returns once the backlog is empty or ctx is
+// done, with the number of events not drained, and the errors of the redelivered
+// events. Buffered events not redelivered stay in the Journal of the source, if
+// any, to be replayed on restart. Events notified after Drain are dropped.
+func (s *K8sNotificationSource) Drain(ctx context.Context) (int, error) {
+	s.drain.draining.Store(true)
+	var errs []error
+	for ctx.Err() == nil {
+		s.pause.mu.Lock()
+		redeliver := s.pause.redeliver
+		if len(s.pause.buffered) == 0 || redeliver == nil {
+			s.pause.mu.Unlock()
+			break
+		}
+		held := s.pause.buffered[0]
+		s.pause.buffered = s.pause.buffered[1:]
+		s.pause.mu.Unlock()
+
+		if err := redeliver(context.WithValue(ctx, redeliveryKey{}, struct{}{}), held.event); err != nil {
+			errs = append(errs, err)
+		}
+		held.release()
+	}
+
+	s.pause.mu.Lock()
+	undrained := len(s.pause.buffered)
+	s.pause.buffered = nil // kept by the journal, not released
+	s.pause.mu.Unlock()
+
+	s.drain.mu.Lock()
+	await := s.drain.await
+	s.drain.mu.Unlock()
+	if await != nil {
+		undrained += await(ctx)
+	}
+	return undrained, errors.Join(errs...)
+}
+
+// Draining reports whether the source was drained, and drops new events.
+func (s *K8sNotificationSource) Draining() bool {
+	return s.drain.draining.Load()
+}
+
+// SetAwaitDispatches is called by the framework core when binding the source.
+func (s *K8sNotificationSource) SetAwaitDispatches(await func(ctx context.Context) int) {
+	s.drain.mu.Lock()
+	defer s.drain.mu.Unlock()
+	s.drain.await = await
+}
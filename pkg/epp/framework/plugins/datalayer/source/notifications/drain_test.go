@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+func TestDrainRedeliversBufferedEvents(t *testing.T) {
+	src, push, delivered := pausedTestSource(t, WithPauseBuffer(3))
+	awaited := false
+	src.SetAwaitDispatches(func(context.Context) int {
+		awaited = true
+		return 0
+	})
+
+	src.Pause()
+	push("buffered-1")
+	push("buffered-2")
+	undrained, err := src.Drain(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, undrained)
+	assert.True(t, awaited, "drain should wait for the events being processed")
+	assert.Equal(t, []string{"buffered-1", "buffered-2"}, delivered())
+
+	push("late")
+	assert.True(t, src.Draining())
+	assert.Equal(t, []string{"buffered-1", "buffered-2"}, delivered(), "a drained source should drop new events")
+	assert.Equal(t, map[SkipReason]uint64{SkipDraining: 1}, src.SkipStats())
+}
+
+func TestDrainTimeout(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK, WithPauseBuffer(3))
+	src.Pause()
+	for _, name := range []string{"a", "b", "c"} {
+		obj := &unstructured.Unstructured{}
+		obj.SetName(name)
+		event, err := src.Notify(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+		require.NoError(t, err)
+		require.Nil(t, event, "paused sources should buffer events")
+	}
+	var redelivered []string
+	src.SetRedeliver(func(ctx context.Context, event fwkdl.NotificationEvent) error {
+		redelivered = append(redelivered, event.Name())
+		<-ctx.Done() // slow extractors
+		return ctx.Err()
+	})
+	src.SetAwaitDispatches(func(context.Context) int { return 1 }) // an event is still being processed
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	undrained, err := src.Drain(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, []string{"a"}, redelivered)
+	assert.Equal(t, 3, undrained, "the events not redelivered, and those being processed, should be reported")
+}
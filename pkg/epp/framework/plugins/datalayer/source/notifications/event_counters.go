@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"maps"
+	"sync"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// EventCounters is a snapshot of the events delivered by a source.
+type EventCounters struct {
+	GVK     schema.GroupVersionKind
+	Adds    uint64 // add/update events for objects not seen before (or since their delete)
+	Updates uint64 // add/update events for known objects
+	Deletes uint64
+}
+
+// eventCounters counts delivered events without locking. Events only tell
+// add-or-update apart from delete, so adds are told from updates by tracking
+// the keys of known objects.
+type eventCounters struct {
+	known   sync.Map // set of live object keys (key=namespaced name)
+	adds    atomic.Uint64
+	updates atomic.Uint64
+	deletes atomic.Uint64
+}
+
+func (c *eventCounters) observe(event fwkdl.NotificationEvent) {
+	key := types.NamespacedName{Namespace: event.Namespace(), Name: event.Name()}
+	if event.Type == fwkdl.EventDelete {
+		c.known.Delete(key)
+		c.deletes.Add(1)
+		return
+	}
+	if _, loaded := c.known.LoadOrStore(key, struct{}{}); loaded {
+		c.updates.Add(1)
+	} else {
+		c.adds.Add(1)
+	}
+}
+
+func (c *eventCounters) snapshot(gvk schema.GroupVersionKind) EventCounters {
+	return EventCounters{
+		GVK:     gvk,
+		Adds:    c.adds.Load(),
+		Updates: c.updates.Load(),
+		Deletes: c.deletes.Load(),
+	}
+}
+
+// SkipReason identifies why Notify dropped an event.
+type SkipReason string
+
+const (
+	// SkipStaleVersion counts events dropped WithStaleEventFilter.
+	SkipStaleVersion SkipReason = "stale-resource-version"
+	// SkipPaused counts events dropped while the source was paused, including
+	// those evicted from a full pause buffer.
+	SkipPaused SkipReason = "paused"
+	// SkipFiltered counts events not matching the source's WithEventFilter.
+	SkipFiltered SkipReason = "filtered"
+	// SkipDraining counts events dropped once the source was drained.
+	SkipDraining SkipReason = "draining"
+	// SkipNilObject counts malformed events without an object.
+	SkipNilObject SkipReason = "nil-object"
+	// SkipUndecodable counts events whose object the source's WithDecoder fails
+	// to decode.
+	SkipUndecodable SkipReason = "undecodable"
+)
+
+// skipCounters counts dropped events by reason.
+type skipCounters struct {
+	mu     sync.Mutex
+	counts map[SkipReason]uint64
+}
+
+func (c *skipCounters) add(reason SkipReason, n uint64) {
+	if n == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.counts == nil {
+		c.counts = make(map[SkipReason]uint64)
+	}
+	c.counts[reason] += n
+}
+
+func (c *skipCounters) snapshot() map[SkipReason]uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return maps.Clone(c.counts)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"maps"
+	"sync"
+)
+
+// extractorToggles tracks the extractors disabled at runtime and the events
+// they skipped.
+type extractorToggles struct {
+	mu       sync.Mutex
+	disabled map[string]bool   // key=extractor name
+	skipped  map[string]uint64 // key=extractor name; kept across re-enabling
+}
+
+// DisableExtractor stops invoking the named extractor on events, without
+// removing it: it stays registered, keeps its state and its place in the
+// dispatch order, and is reported as skipping the events. An extractor disabled
+// before it is registered is disabled once it is.
+func (s *K8sNotificationSource) DisableExtractor(name string) {
+	s.toggles.mu.Lock()
+	defer s.toggles.mu.Unlock()
+	if s.toggles.disabled == nil {
+		s.toggles.disabled = make(map[string]bool)
+	}
+	s.toggles.disabled[name] = true
+}
+
+// EnableExtractor resumes invoking an extractor disabled with DisableExtractor.
+// Events fired while it was disabled are not redelivered.
+func (s *K8sNotificationSource) EnableExtractor(name string) {
+	s.toggles.mu.Lock()
+	defer s.toggles.mu.Unlock()
+	delete(s.toggles.disabled, name)
+}
+
+// SkipExtractor is called by the framework core before invoking an extractor.
+func (s *K8sNotificationSource) SkipExtractor(name string) bool {
+	s.toggles.mu.Lock()
+	defer s.toggles.mu.Unlock()
+	if !s.toggles.disabled[name] {
+		return false
+	}
+	if s.toggles.skipped == nil {
+		s.toggles.skipped = make(map[string]uint64)
+	}
+	s.toggles.skipped[name]++
+	return true
+}
+
+// DisabledSkips returns the number of events skipped by each extractor while
+// disabled, by extractor name.
+func (s *K8sNotificationSource) DisabledSkips() map[string]uint64 {
+	s.toggles.mu.Lock()
+	defer s.toggles.mu.Unlock()
+	return maps.Clone(s.toggles.skipped)
+}
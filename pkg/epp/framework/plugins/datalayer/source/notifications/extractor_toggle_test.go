@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractorToggles(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK)
+	assert.False(t, src.SkipExtractor("a"), "extractors should be enabled by default")
+
+	src.DisableExtractor("a")
+	assert.True(t, src.SkipExtractor("a"))
+	assert.True(t, src.SkipExtractor("a"))
+	assert.False(t, src.SkipExtractor("b"), "other extractors should not be affected")
+
+	src.EnableExtractor("a")
+	assert.False(t, src.SkipExtractor("a"))
+	assert.Equal(t, map[string]uint64{"a": 2}, src.DisabledSkips(), "skips should be kept after re-enabling")
+}
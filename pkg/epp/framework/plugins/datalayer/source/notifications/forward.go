@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// forwardMu serializes changes to the forwarding topology of all sources, so
+// that cycles are detected reliably.
+var forwardMu sync.Mutex
+
+// ForwardFrom subscribes the source to the events accepted by src, so that they
+// also reach the extractors bound to this source, e.g., to aggregate the events
+// of several sources in a single pipeline. Forwarded events go through this
+// source's Notify, as events it receives itself do, and carry a deep copy of the
+// object: the extractors of both sources never share it. They may be of another
+// GVK than this source's, which extractors can check with the object's
+// GroupVersionKind.
+//
+// Events are forwarded synchronously from src's Notify, before src's extractors
+// run. Failures processing a forwarded event are reported by the framework core
+// for this source and do not affect src. Events are only forwarded once the
+// framework core bound this source. Forwarding cycles are rejected.
+func (s *K8sNotificationSource) ForwardFrom(src *K8sNotificationSource) error {
+	if src == nil {
+		return errors.New("cannot forward from a nil source")
+	}
+	forwardMu.Lock()
+	defer forwardMu.Unlock()
+
+	if src == s || s.forwardsTo(src) {
+		return fmt.Errorf("forwarding from %s to %s would create a cycle", src.typedName, s.typedName)
+	}
+	targets := src.forwardTargets()
+	if slices.Contains(targets, s) {
+		return nil
+	}
+	src.forwards.Store(append(slices.Clone(targets), s)) // copy-on-write: Notify reads without locking
+	return nil
+}
+
+// forwardsTo reports whether events of the source reach target, directly or
+// through other sources. The caller must hold forwardMu.
+func (s *K8sNotificationSource) forwardsTo(target *K8sNotificationSource) bool {
+	for _, next := range s.forwardTargets() {
+		if next == target || next.forwardsTo(target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *K8sNotificationSource) forwardTargets() []*K8sNotificationSource {
+	if targets := s.forwards.Load(); targets != nil {
+		return targets.([]*K8sNotificationSource)
+	}
+	return nil
+}
+
+// forward delivers a copy of the event to the subscribed sources.
+func (s *K8sNotificationSource) forward(ctx context.Context, event fwkdl.NotificationEvent) {
+	ctx = context.WithValue(ctx, redeliveryKey{}, nil) // a redelivery to this source is not one to the targets
+	for _, target := range s.forwardTargets() {
+		target.pause.mu.Lock()
+		redeliver := target.pause.redeliver
+		target.pause.mu.Unlock()
+		if redeliver == nil {
+			continue // not bound yet
+		}
+
+		forwarded := fwkdl.NotificationEvent{Type: event.Type, Meta: event.Meta}
+		if event.Object != nil {
+			forwarded.Object = event.Object.DeepCopy()
+		}
+		_ = redeliver(ctx, forwarded) // reported by the core dispatching the event
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardFromRejectsCycles(t *testing.T) {
+	a := NewK8sNotificationSource(NotificationSourceType, "a", testGVK)
+	b := NewK8sNotificationSource(NotificationSourceType, "b", testGVK)
+	c := NewK8sNotificationSource(NotificationSourceType, "c", testGVK)
+
+	require.NoError(t, b.ForwardFrom(a))
+	require.NoError(t, c.ForwardFrom(b))
+	require.NoError(t, c.ForwardFrom(b), "subscribing twice is a no-op")
+	assert.Len(t, b.forwardTargets(), 1)
+
+	assert.ErrorContains(t, a.ForwardFrom(a), "cycle")
+	assert.ErrorContains(t, a.ForwardFrom(c), "cycle", "a -> b -> c -> a")
+	assert.Error(t, a.ForwardFrom(nil))
+}
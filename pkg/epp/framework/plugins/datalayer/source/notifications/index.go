@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// WithIndex indexes the objects of the source in the informer cache under the
+// keys returned by fn, e.g., the value of a label, for extractors to Lookup.
+func WithIndex(name string, fn fwkdl.IndexFunc) SourceOption {
+	return func(s *K8sNotificationSource) {
+		if s.indexes == nil {
+			s.indexes = make(map[string]fwkdl.IndexFunc)
+		}
+		s.indexes[name] = fn
+	}
+}
+
+// Indexes returns the index functions set WithIndex, by name.
+func (s *K8sNotificationSource) Indexes() map[string]fwkdl.IndexFunc {
+	return maps.Clone(s.indexes)
+}
+
+// SetLookup is called by the framework core when binding the source.
+func (s *K8sNotificationSource) SetLookup(lookup fwkdl.LookupFunc) {
+	s.lookup.Store(&lookup)
+}
+
+// Lookup returns the objects of the informer cache indexed under key by the named
+// index, set WithIndex. It fails until the source is bound.
+func (s *K8sNotificationSource) Lookup(ctx context.Context, index, key string) ([]*unstructured.Unstructured, error) {
+	if _, ok := s.indexes[index]; !ok {
+		return nil, fmt.Errorf("source %s: unknown index %q", s.typedName, index)
+	}
+	lookup := s.lookup.Load()
+	if lookup == nil {
+		return nil, fmt.Errorf("source %s: not bound to an informer cache", s.typedName)
+	}
+	return (*lookup)(ctx, index, key)
+}
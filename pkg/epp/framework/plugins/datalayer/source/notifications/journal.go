@@ -0,0 +1,348 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// WithJournal makes the source journal its events, so that events not processed
+// before a crash are replayed by the framework core on restart.
+func WithJournal(journal fwkdl.Journal) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.journal = journal
+	}
+}
+
+// Journal returns the journal set WithJournal, or nil.
+func (s *K8sNotificationSource) Journal() fwkdl.Journal {
+	return s.journal
+}
+
+// journalEntry is a line of a FileJournal: an appended event, or the sequence
+// number of an event marked processed.
+type journalEntry struct {
+	Seq    uint64          `json:"seq"`
+	Done   bool            `json:"done,omitempty"`
+	Type   fwkdl.EventType `json:"type,omitempty"`
+	Object json.RawMessage `json:"object,omitempty"`
+
+	size int64 // of the line of the entry in the file
+}
+
+const (
+	// defaultCompactSize is the size the journal file grows to before it is
+	// compacted down to the events still pending.
+	defaultCompactSize = 4 << 20
+	// maxJournalLine is the size of the largest entry of the journal file: larger
+	// events are not journaled, and larger lines are skipped when reading.
+	maxJournalLine = 16 << 20
+)
+
+// FileJournal is a Journal appending events to a file, one JSON line each, and
+// synced to disk before the event is processed. The file is compacted down to
+// the pending events by Replay, and once it outgrows both a threshold and twice
+// the size of the pending events, so that compactions are amortized over the
+// events processed in between.
+type FileJournal struct {
+	path        string
+	mu          sync.Mutex
+	file        *os.File
+	seq         uint64                  // of the last appended event
+	pending     map[uint64]journalEntry // appended and not processed yet, by sequence number
+	size        int64                   // of the file
+	live        int64                   // of the lines of the pending entries
+	compactSize int64                   // size of the file triggering a compaction
+}
+
+var _ fwkdl.Journal = (*FileJournal)(nil)
+
+// NewFileJournal opens the journal at path, creating it if needed. Events left
+// unprocessed by a previous run are kept for Replay.
+func NewFileJournal(path string) (*FileJournal, error) {
+	j := &FileJournal{path: path, pending: make(map[uint64]journalEntry), compactSize: defaultCompactSize}
+	entries, err := j.read()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		j.seq = max(j.seq, entry.Seq)
+	}
+	for _, entry := range pending(entries) {
+		j.pending[entry.Seq] = entry
+	}
+	// Compacting also drops a truncated last line, which new entries would extend.
+	if err := j.rewrite(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// Append implements fwkdl.Journal.
+func (j *FileJournal) Append(event fwkdl.NotificationEvent) (func() error, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	entry := journalEntry{Seq: j.seq + 1, Type: event.Type}
+	if event.Object != nil {
+		object, err := json.Marshal(event.Object.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode journal entry: %w", err)
+		}
+		entry.Object = object
+	}
+	n, err := j.write(entry)
+	if err != nil {
+		return nil, err
+	}
+	entry.size = n
+	j.seq = entry.Seq
+	j.pending[entry.Seq] = entry
+	j.live += n
+	return func() error {
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		current, ok := j.pending[entry.Seq]
+		if !ok {
+			return nil // compacted away by Replay
+		}
+		delete(j.pending, entry.Seq)
+		j.live -= current.size
+		if _, err := j.write(journalEntry{Seq: entry.Seq, Done: true}); err != nil {
+			return err
+		}
+		if j.size >= j.compactSize && j.size >= 2*j.live {
+			return j.rewrite()
+		}
+		return nil
+	}, nil
+}
+
+// Replay implements fwkdl.Journal. The journal is not locked while fn runs, so
+// events can be appended meanwhile, and are not replayed. Events that fail to
+// decode are kept for the next replay, as are those fn fails.
+func (j *FileJournal) Replay(fn func(event fwkdl.NotificationEvent) error) error {
+	j.mu.Lock()
+	entries := j.pendingEntries()
+	j.mu.Unlock()
+
+	var replayed []uint64
+	var errs []error
+	for _, entry := range entries {
+		event := fwkdl.NotificationEvent{Type: entry.Type}
+		if entry.Object != nil {
+			object := map[string]any{}
+			if err := utiljson.Unmarshal(entry.Object, &object); err != nil { // keeps integers as int64
+				errs = append(errs, fmt.Errorf("event %d: failed to decode object: %w", entry.Seq, err))
+				continue
+			}
+			event.Object = &unstructured.Unstructured{Object: object}
+		}
+		if err := fn(event); err != nil {
+			errs = append(errs, fmt.Errorf("event %d: %w", entry.Seq, err))
+			continue
+		}
+		replayed = append(replayed, entry.Seq)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, seq := range replayed {
+		delete(j.pending, seq)
+	}
+	if err := j.rewrite(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes the journal file.
+func (j *FileJournal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.file.Close()
+}
+
+// pendingEntries returns the pending entries, in order. The caller must hold j.mu.
+func (j *FileJournal) pendingEntries() []journalEntry {
+	return slices.SortedFunc(maps.Values(j.pending), func(a, b journalEntry) int { return cmp.Compare(a.Seq, b.Seq) })
+}
+
+// pending returns the appended entries not marked done, in order.
+func pending(entries []journalEntry) []journalEntry {
+	done := make(map[uint64]bool)
+	for _, entry := range entries {
+		if entry.Done {
+			done[entry.Seq] = true
+		}
+	}
+	var result []journalEntry
+	for _, entry := range entries {
+		if !entry.Done && !done[entry.Seq] {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// write appends an entry, syncs the file, and returns the size of the line of
+// the entry. The caller must hold j.mu.
+func (j *FileJournal) write(entry journalEntry) (int64, error) {
+	line, err := encodeEntry(entry)
+	if err != nil {
+		return 0, err
+	}
+	n, err := j.file.Write(line)
+	j.size += int64(n)
+	if err != nil {
+		return 0, fmt.Errorf("failed to write journal: %w", err)
+	}
+	return int64(n), j.file.Sync()
+}
+
+// encodeEntry returns the line of an entry, refusing those too large to be read.
+func encodeEntry(entry journalEntry) ([]byte, error) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode journal entry: %w", err)
+	}
+	if len(line) >= maxJournalLine {
+		return nil, fmt.Errorf("journal entry of %d bytes exceeds the limit of %d", len(line)+1, maxJournalLine)
+	}
+	return append(line, '\n'), nil
+}
+
+// read returns the entries of the journal file, which may not exist yet. A
+// truncated last line, left by a crash while appending, is ignored, and lines
+// too large to be entries are skipped and logged.
+func (j *FileJournal) read() ([]journalEntry, error) {
+	f, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open journal: %w", err)
+	}
+	defer f.Close()
+
+	var entries []journalEntry
+	r := bufio.NewReader(f)
+	for number := 1; ; number++ {
+		line, tooLong, err := readLine(r, maxJournalLine)
+		if tooLong {
+			log.Log.Error(errors.New("journal line too long"), "skipping journal entry",
+				"path", j.path, "line", number, "limit", maxJournalLine)
+		} else if len(line) > 0 {
+			var entry journalEntry
+			if json.Unmarshal(line, &entry) == nil {
+				entries = append(entries, entry)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read journal: %w", err)
+		}
+	}
+}
+
+// readLine reads the next line of r, and reports whether it exceeds limit, in
+// which case it is discarded rather than returned.
+func readLine(r *bufio.Reader, limit int) ([]byte, bool, error) {
+	var line []byte
+	tooLong := false
+	for {
+		chunk, err := r.ReadSlice('\n')
+		if !tooLong {
+			if len(line)+len(chunk) > limit {
+				tooLong, line = true, nil
+			} else {
+				line = append(line, chunk...)
+			}
+		}
+		if !errors.Is(err, bufio.ErrBufferFull) {
+			return line, tooLong, err
+		}
+	}
+}
+
+// rewrite atomically replaces the journal file with the pending entries and
+// reopens it for appending. The caller must hold j.mu.
+func (j *FileJournal) rewrite() error {
+	tmp := j.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to compact journal: %w", err)
+	}
+	w := bufio.NewWriter(f)
+	var live int64
+	for _, entry := range j.pendingEntries() {
+		var line []byte
+		if line, err = encodeEntry(entry); err != nil {
+			break
+		}
+		if _, err = w.Write(line); err != nil {
+			break
+		}
+		entry.size = int64(len(line))
+		j.pending[entry.Seq] = entry
+		live += entry.size
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, j.path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to compact journal: %w", err)
+	}
+
+	if j.file != nil {
+		_ = j.file.Close()
+	}
+	if j.file, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0o600); err != nil {
+		return fmt.Errorf("failed to reopen journal: %w", err)
+	}
+	info, err := j.file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to reopen journal: %w", err)
+	}
+	j.size, j.live = info.Size(), live
+	return nil
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+func journalTestEvent(eventType fwkdl.EventType, name string) fwkdl.NotificationEvent {
+	obj := &unstructured.Unstructured{}
+	obj.SetNamespace("default")
+	obj.SetName(name)
+	obj.SetGeneration(7)
+	return fwkdl.NotificationEvent{Type: eventType, Object: obj}
+}
+
+func replayAll(t *testing.T, j *FileJournal, fail map[string]bool) ([]fwkdl.NotificationEvent, error) {
+	t.Helper()
+	var replayed []fwkdl.NotificationEvent
+	err := j.Replay(func(event fwkdl.NotificationEvent) error {
+		replayed = append(replayed, event)
+		if fail[event.Name()] {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	return replayed, err
+}
+
+func TestFileJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	j, err := NewFileJournal(path)
+	require.NoError(t, err)
+
+	var dones []func() error
+	for i, name := range []string{"a", "b", "c"} {
+		eventType := fwkdl.EventAddOrUpdate
+		if i == 2 {
+			eventType = fwkdl.EventDelete
+		}
+		done, err := j.Append(journalTestEvent(eventType, name))
+		require.NoError(t, err)
+		dones = append(dones, done)
+	}
+	require.NoError(t, dones[1]())
+	require.NoError(t, j.Close())
+
+	// restart
+	j, err = NewFileJournal(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = j.Close() })
+	replayed, err := replayAll(t, j, map[string]bool{"c": true})
+	require.ErrorContains(t, err, "boom")
+	require.Len(t, replayed, 2, "processed events should not be replayed")
+	assert.Equal(t, "a", replayed[0].Name())
+	assert.Equal(t, fwkdl.EventAddOrUpdate, replayed[0].Type)
+	assert.Equal(t, int64(7), replayed[0].Object.GetGeneration())
+	assert.Equal(t, "c", replayed[1].Name())
+	assert.Equal(t, fwkdl.EventDelete, replayed[1].Type)
+
+	done, err := j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "d"))
+	require.NoError(t, err)
+	replayed, err = replayAll(t, j, nil)
+	require.NoError(t, err)
+	require.Len(t, replayed, 2, "failed replays should be kept, after the compaction")
+	assert.Equal(t, "c", replayed[0].Name())
+	assert.Equal(t, "d", replayed[1].Name())
+	require.NoError(t, done(), "marking an event compacted away should be harmless")
+
+	replayed, err = replayAll(t, j, nil)
+	require.NoError(t, err)
+	assert.Empty(t, replayed)
+}
+
+func TestFileJournalTruncatedEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	j, err := NewFileJournal(path)
+	require.NoError(t, err)
+	_, err = j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "a"))
+	require.NoError(t, err)
+	require.NoError(t, j.Close())
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"seq":2,"obj`) // crash while appending
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	j, err = NewFileJournal(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = j.Close() })
+	_, err = j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "b"))
+	require.NoError(t, err)
+	replayed, err := replayAll(t, j, nil)
+	require.NoError(t, err)
+	require.Len(t, replayed, 2, "the truncated entry should not corrupt the next one")
+	assert.Equal(t, "a", replayed[0].Name())
+	assert.Equal(t, "b", replayed[1].Name())
+}
+
+func TestFileJournalCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	j, err := NewFileJournal(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = j.Close() })
+	j.compactSize = 1024
+
+	_, err = j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "pending"))
+	require.NoError(t, err)
+	for range 100 {
+		done, err := j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "processed"))
+		require.NoError(t, err)
+		require.NoError(t, done())
+	}
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Less(t, info.Size(), int64(2048), "the journal should be compacted as it grows")
+
+	require.NoError(t, j.Close())
+	j, err = NewFileJournal(path)
+	require.NoError(t, err)
+	replayed, err := replayAll(t, j, nil)
+	require.NoError(t, err)
+	require.Len(t, replayed, 1, "compaction should keep the pending events")
+	assert.Equal(t, "pending", replayed[0].Name())
+}
+
+func TestFileJournalCompactionAmortized(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	j, err := NewFileJournal(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = j.Close() })
+	j.compactSize = 1024
+
+	for range 50 { // the pending events alone outgrow the threshold
+		_, err = j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "pending"))
+		require.NoError(t, err)
+	}
+	rewrites := 0
+	for range 200 {
+		before, err := os.Stat(path)
+		require.NoError(t, err)
+		done, err := j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "processed"))
+		require.NoError(t, err)
+		require.NoError(t, done())
+		after, err := os.Stat(path)
+		require.NoError(t, err)
+		if !os.SameFile(before, after) {
+			rewrites++
+		}
+		assert.LessOrEqual(t, after.Size(), 2*j.live+int64(1024), "the journal should stay within twice the pending events")
+	}
+	assert.Less(t, rewrites, 20, "compactions should be amortized over the processed events")
+}
+
+func TestFileJournalOversizedLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	j, err := NewFileJournal(path)
+	require.NoError(t, err)
+	_, err = j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "a"))
+	require.NoError(t, err)
+
+	oversized := journalTestEvent(fwkdl.EventAddOrUpdate, "oversized")
+	oversized.Object.SetAnnotations(map[string]string{"large": strings.Repeat("x", maxJournalLine)})
+	_, err = j.Append(oversized)
+	require.ErrorContains(t, err, "exceeds the limit", "events too large to be read back should not be journaled")
+	require.NoError(t, j.Close())
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(strings.Repeat("x", maxJournalLine+1) + "\n" + `{"seq":3}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	j, err = NewFileJournal(path)
+	require.NoError(t, err, "an oversized line should not prevent opening the journal")
+	t.Cleanup(func() { _ = j.Close() })
+	replayed, err := replayAll(t, j, nil)
+	require.NoError(t, err)
+	require.Len(t, replayed, 2, "the entries around the oversized line should be kept")
+	assert.Equal(t, "a", replayed[0].Name())
+}
+
+func TestFileJournalUndecodableEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	require.NoError(t, os.WriteFile(path, []byte(`{"seq":1,"object":42}`+"\n"), 0o600))
+
+	j, err := NewFileJournal(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = j.Close() })
+	for range 2 {
+		replayed, err := replayAll(t, j, nil)
+		require.ErrorContains(t, err, "failed to decode object")
+		assert.Empty(t, replayed)
+	}
+}
+
+func TestFileJournalAppendDuringReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	j, err := NewFileJournal(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = j.Close() })
+	_, err = j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "a"))
+	require.NoError(t, err)
+
+	var appended func() error
+	err = j.Replay(func(fwkdl.NotificationEvent) error {
+		var err error
+		appended, err = j.Append(journalTestEvent(fwkdl.EventAddOrUpdate, "b")) // would deadlock if Replay held the lock
+		return err
+	})
+	require.NoError(t, err)
+
+	replayed, err := replayAll(t, j, nil)
+	require.NoError(t, err)
+	require.Len(t, replayed, 1, "events appended during a replay should be kept")
+	assert.Equal(t, "b", replayed[0].Name())
+	require.NoError(t, appended())
+}
@@ -18,33 +18,314 @@ package notifications
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 )
 
 var (
-	_ fwkdl.DataSource         = (*K8sNotificationSource)(nil)
-	_ fwkdl.NotificationSource = (*K8sNotificationSource)(nil)
+	_ fwkdl.DataSource              = (*K8sNotificationSource)(nil)
+	_ fwkdl.NotificationSource      = (*K8sNotificationSource)(nil)
+	_ fwkdl.DeepCopyOptOutSource    = (*K8sNotificationSource)(nil)
+	_ fwkdl.SyncAwareSource         = (*K8sNotificationSource)(nil)
+	_ fwkdl.TracingSource           = (*K8sNotificationSource)(nil)
+	_ fwkdl.FairDispatchSource      = (*K8sNotificationSource)(nil)
+	_ fwkdl.DispatchOptionsSource   = (*K8sNotificationSource)(nil)
+	_ fwkdl.InitialExtractorsSource = (*K8sNotificationSource)(nil)
+	_ fwkdl.ErrorLimitSource        = (*K8sNotificationSource)(nil)
+	_ fwkdl.HeartbeatSource         = (*K8sNotificationSource)(nil)
+	_ fwkdl.UnboundWarningSource    = (*K8sNotificationSource)(nil)
+	_ fwkdl.RedeliverySource        = (*K8sNotificationSource)(nil)
+	_ fwkdl.DrainableSource         = (*K8sNotificationSource)(nil)
+	_ fwkdl.CopyFuncSource          = (*K8sNotificationSource)(nil)
+	_ fwkdl.JournalingSource        = (*K8sNotificationSource)(nil)
+	_ fwkdl.ExtractorToggleSource   = (*K8sNotificationSource)(nil)
+	_ fwkdl.WatchOptionsSource      = (*K8sNotificationSource)(nil)
+	_ fwkdl.ResyncSource            = (*K8sNotificationSource)(nil)
+	_ fwkdl.IndexingSource          = (*K8sNotificationSource)(nil)
 )
 
 // K8sNotificationSource watches a single GVK and dispatches events to
 // registered NotificationExtractors.
 type K8sNotificationSource struct {
-	typedName fwkplugin.TypedName
-	gvk       schema.GroupVersionKind
+	typedName    fwkplugin.TypedName
+	gvk          schema.GroupVersionKind
+	skipDeepCopy bool
+	copyFunc     fwkdl.CopyFunc // nil uses the core's deep-copy
+	ack          fwkdl.AckFunc  // nil disables acknowledgements
+	decoder      ObjectDecoder
+	versions     *resourceVersionTracker // nil unless stale events are filtered
+	tracer       trace.Tracer            // nil disables extractor spans
+	fairDispatch bool
+	dryRun       bool
+	strategy     fwkdl.DispatchStrategy // nil uses the core's default
+	auditSink    fwkdl.AuditSink        // nil disables audit records
+	deadline     time.Duration          // per extractor invocation; <= 0 disables it
+	maxErrors    int                    // errors logged per event; <= 0 uses the core default
+	heartbeat    time.Duration          // interval of running extractor logs; <= 0 disables them
+	resync       time.Duration          // interval of informer resyncs; <= 0 disables them
+	warnUnbound  bool
+	journal      fwkdl.Journal      // nil disables journaling
+	watch        fwkdl.WatchOptions // zero watches and dispatches all objects
+	extractors   []fwkdl.Extractor  // registered by the Runtime on Configure
+	synced       atomic.Bool        // set by the core once the initial list completed
+	syncMu       sync.Mutex
+	syncCh       chan struct{} // closed on SetSynced; created on first use
+	syncOnce     sync.Once
+	pause        pauseState
+	drain        drainState
+	toggles      extractorToggles
+	forwards     atomic.Value // []*K8sNotificationSource; sources subscribed with ForwardFrom
+	counters     eventCounters
+	skips        skipCounters
+	indexes      map[string]fwkdl.IndexFunc       // set WithIndex, by name
+	lookup       atomic.Pointer[fwkdl.LookupFunc] // set by the core on bind
+}
+
+// ObjectDecoder converts an unstructured event object into a typed API object.
+type ObjectDecoder func(*unstructured.Unstructured) (runtime.Object, error)
+
+// DecodeAs returns an ObjectDecoder converting objects into a new *T, for
+// example DecodeAs[corev1.Pod]().
+func DecodeAs[T any, PT interface {
+	*T
+	runtime.Object
+}]() ObjectDecoder {
+	return func(u *unstructured.Unstructured) (runtime.Object, error) {
+		typed := PT(new(T))
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), typed); err != nil {
+			return nil, err
+		}
+		return typed, nil
+	}
+}
+
+// SourceOption configures optional behavior of a K8sNotificationSource.
+type SourceOption func(*K8sNotificationSource)
+
+// WithoutDeepCopy asks the framework core to deliver objects straight from the
+// informer cache, without deep-copying them first. This trades safety for
+// throughput on hot paths.
+//
+// WARNING: extractors then share the cached object with every other cache reader.
+// Mutating it, even temporarily, corrupts the cache. The core ignores this option
+// (and logs) if more than one extractor is bound to the source. Only use it with a
+// single extractor that never modifies the event object.
+func WithoutDeepCopy() SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.skipDeepCopy = true
+	}
+}
+
+// WithCopyFunc replaces the deep-copy of event objects by the framework core with
+// fn, e.g., to prune fields the extractors don't need from large objects. fn must
+// return an object sharing no memory with its argument, which is held by the
+// shared informer cache. Takes precedence over WithoutDeepCopy.
+func WithCopyFunc(fn fwkdl.CopyFunc) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.copyFunc = fn
+	}
+}
+
+// WithAckFunc makes the framework core call fn with each event processed
+// successfully by all extractors of the source, and its dispatch outcome, e.g.,
+// to advance a checkpoint. fn is called synchronously before the next event of
+// the object is processed.
+func WithAckFunc(fn fwkdl.AckFunc) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.ack = fn
+	}
+}
+
+// WithDecoder configures the source to decode every event object once, before it
+// is dispatched, and deliver the result in NotificationEvent.Typed. This spares
+// each extractor from converting the unstructured object itself. Events whose
+// object fails to decode are dropped (see SkipUndecodable), not retried.
+func WithDecoder(decoder ObjectDecoder) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.decoder = decoder
+	}
+}
+
+// WithStaleEventFilter drops add/update events whose resourceVersion is not newer
+// than the last one processed for the same object, such as replays during informer
+// reconnects. Delete events are always delivered.
+func WithStaleEventFilter() SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.versions = newResourceVersionTracker()
+	}
+}
+
+// WithTracer enables a tracing span around every extractor invocation, tagged
+// with the extractor name and event type. A nil tracer disables tracing.
+func WithTracer(tracer trace.Tracer) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.tracer = tracer
+	}
+}
+
+// WithFairDispatch asks the framework core to run the source's extractors
+// fastest-first by average latency, instead of in configured order, while still
+// honoring declared extractor dependencies.
+func WithFairDispatch() SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.fairDispatch = true
+	}
+}
+
+// WithEventFilter makes the source drop the events not matching the predicate,
+// before they reach any extractor. The predicate sees the decoded object of
+// sources created WithDecoder. It sets the Filter of the WatchOptions.
+func WithEventFilter(filter fwkdl.EventPredicate) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.watch.Filter = filter
+	}
+}
+
+// WithWatchOptions scopes the objects watched by the framework core and the
+// events dispatched by the source, replacing the options set by earlier
+// options, e.g., WithEventFilter.
+func WithWatchOptions(opts fwkdl.WatchOptions) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.watch = opts
+		s.watch.Namespaces = slices.Clone(opts.Namespaces)
+	}
+}
+
+// WithDryRun dispatches the events of the source in dry-run mode: extractors see
+// fwkdl.IsDryRun on their context and are expected to skip their side effects,
+// e.g., to verify a new extractor against live events.
+func WithDryRun() SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.dryRun = true
+	}
+}
+
+// WithDispatchStrategy replaces the sequential dispatch of events to the
+// source's extractors, e.g., to run independent extractors concurrently.
+func WithDispatchStrategy(strategy fwkdl.DispatchStrategy) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.strategy = strategy
+	}
+}
+
+// WithAuditSink sends a record of every processed event, including the outcome
+// of each extractor, to the given sink.
+func WithAuditSink(sink fwkdl.AuditSink) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.auditSink = sink
+	}
+}
+
+// WithExtractorDeadline bounds every extractor invocation by the given deadline.
+// Extractors that keep running past it without honoring the context cancellation
+// are logged, to help find plugins that detach from the request context.
+func WithExtractorDeadline(deadline time.Duration) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.deadline = deadline
+	}
+}
+
+// WithUnboundWarning logs a warning, rate-limited, when the source is notified
+// while no extractor is bound to it, to surface sources whose extractors failed
+// to attach.
+func WithUnboundWarning() SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.warnUnbound = true
+	}
+}
+
+// WithExtractorHeartbeat logs, at debug level and every interval, the extractor
+// still processing an event and for how long, so that an extractor stuck in
+// dispatch can be identified while it runs.
+func WithExtractorHeartbeat(interval time.Duration) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.heartbeat = interval
+	}
+}
+
+// WithResyncPeriod makes the framework core deliver all objects of the source
+// again every period, as the informer resyncs them, even if unchanged. Zero, the
+// default, disables resyncs; NewK8sNotificationSourceE rejects negative periods.
+func WithResyncPeriod(period time.Duration) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.resync = period
+	}
+}
+
+// WithMaxLoggedErrors caps the number of extractor errors included in the log
+// line reporting the failures of an event, so that an outage failing many
+// extractors does not produce lines too large for log shippers. The number of
+// errors left out is still reported.
+func WithMaxLoggedErrors(n int) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.maxErrors = n
+	}
+}
+
+// WithExtractors binds extractors to the source at construction. The Runtime
+// registers them when the configuration is loaded, ahead of the extractors
+// configured for the source. Use NewK8sNotificationSourceE to validate them
+// upfront.
+func WithExtractors(extractors ...fwkdl.Extractor) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.extractors = append(s.extractors, extractors...)
+	}
 }
 
 // NewK8sNotificationSource returns a new notification source for the given GVK.
 func NewK8sNotificationSource(pluginType, pluginName string,
-	gvk schema.GroupVersionKind) *K8sNotificationSource {
-	return &K8sNotificationSource{
+	gvk schema.GroupVersionKind, opts ...SourceOption) *K8sNotificationSource {
+	s := &K8sNotificationSource{
 		typedName: fwkplugin.TypedName{Type: pluginType, Name: pluginName},
 		gvk:       gvk,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewK8sNotificationSourceE is NewK8sNotificationSource, but fails if any of the
+// extractors set WithExtractors is not a NotificationExtractor for the source's
+// GVK, if two of them share a name, or if the resync period is negative.
+func NewK8sNotificationSourceE(pluginType, pluginName string,
+	gvk schema.GroupVersionKind, opts ...SourceOption) (*K8sNotificationSource, error) {
+	s := NewK8sNotificationSource(pluginType, pluginName, gvk, opts...)
+	if s.resync < 0 {
+		return nil, fmt.Errorf("source %s: negative resync period %s", s.typedName, s.resync)
+	}
+	names := make(map[string]bool, len(s.extractors))
+	for i, ext := range s.extractors {
+		if ext == nil {
+			return nil, fmt.Errorf("source %s: extractor %d is nil", s.typedName, i)
+		}
+		notifier, ok := ext.(fwkdl.NotificationExtractor)
+		if !ok {
+			return nil, fmt.Errorf("source %s: extractor %s is not a notification extractor", s.typedName, ext.TypedName())
+		}
+		if notifier.GVK() != gvk {
+			return nil, fmt.Errorf("source %s: extractor %s GVK %s does not match source GVK %s",
+				s.typedName, ext.TypedName(), notifier.GVK(), gvk)
+		}
+		if names[ext.TypedName().Name] {
+			return nil, fmt.Errorf("source %s: duplicate extractor name %q", s.typedName, ext.TypedName().Name)
+		}
+		names[ext.TypedName().Name] = true
+	}
+	return s, nil
 }
 
 // TypedName returns the plugin type and name.
@@ -57,6 +338,121 @@ func (s *K8sNotificationSource) GVK() schema.GroupVersionKind {
 	return s.gvk
 }
 
+// Tracer returns the tracer configured WithTracer, or nil.
+func (s *K8sNotificationSource) Tracer() trace.Tracer {
+	return s.tracer
+}
+
+// FairDispatch reports whether the source was created WithFairDispatch.
+func (s *K8sNotificationSource) FairDispatch() bool {
+	return s.fairDispatch
+}
+
+// DispatchOptions returns the options set WithDispatchStrategy,
+// WithExtractorDeadline, WithAckFunc and WithAuditSink.
+func (s *K8sNotificationSource) DispatchOptions() fwkdl.DispatchOptions {
+	return fwkdl.DispatchOptions{
+		Strategy:          s.strategy,
+		ExtractorDeadline: s.deadline,
+		Ack:               s.ack,
+		Audit:             s.auditSink,
+	}
+}
+
+// WatchOptions returns the effective watch options of the source, e.g., for
+// debugging which objects it is notified of.
+func (s *K8sNotificationSource) WatchOptions() fwkdl.WatchOptions {
+	opts := s.watch
+	opts.Namespaces = slices.Clone(s.watch.Namespaces)
+	return opts
+}
+
+// InitialExtractors returns the extractors set WithExtractors.
+func (s *K8sNotificationSource) InitialExtractors() []fwkdl.Extractor {
+	return s.extractors
+}
+
+// WarnWithoutExtractors reports whether the source was created WithUnboundWarning.
+func (s *K8sNotificationSource) WarnWithoutExtractors() bool {
+	return s.warnUnbound
+}
+
+// ExtractorHeartbeat returns the interval set WithExtractorHeartbeat, or zero.
+func (s *K8sNotificationSource) ExtractorHeartbeat() time.Duration {
+	return s.heartbeat
+}
+
+// ResyncPeriod returns the period set WithResyncPeriod, or zero.
+func (s *K8sNotificationSource) ResyncPeriod() time.Duration {
+	return s.resync
+}
+
+// MaxLoggedErrors returns the limit set WithMaxLoggedErrors, or zero.
+func (s *K8sNotificationSource) MaxLoggedErrors() int {
+	return s.maxErrors
+}
+
+// CopyFunc returns the function set WithCopyFunc, or nil.
+func (s *K8sNotificationSource) CopyFunc() fwkdl.CopyFunc {
+	return s.copyFunc
+}
+
+// SkipDeepCopy reports whether the source was created WithoutDeepCopy.
+func (s *K8sNotificationSource) SkipDeepCopy() bool {
+	return s.skipDeepCopy
+}
+
+// SetSynced is called by the framework core once the initial list of the GVK
+// has been delivered.
+func (s *K8sNotificationSource) SetSynced() {
+	s.synced.Store(true)
+	s.syncOnce.Do(func() { close(s.syncedChan()) })
+}
+
+// HasSynced reports whether the initial list of the GVK has been delivered.
+// Before that, a missing object may simply not have been listed yet, so
+// consumers should not treat its absence as a delete.
+func (s *K8sNotificationSource) HasSynced() bool {
+	return s.synced.Load()
+}
+
+// WaitForSync blocks until the initial list of the GVK has been delivered, e.g.,
+// to hold off serving traffic until the source's data is complete. Returns an
+// error if ctx is done first.
+func (s *K8sNotificationSource) WaitForSync(ctx context.Context) error {
+	if s.HasSynced() {
+		return nil
+	}
+	select {
+	case <-s.syncedChan():
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("source %s: waiting for the initial list of %s: %w", s.typedName, s.gvk.Kind, context.Cause(ctx))
+	}
+}
+
+func (s *K8sNotificationSource) syncedChan() chan struct{} {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+	if s.syncCh == nil {
+		s.syncCh = make(chan struct{})
+	}
+	return s.syncCh
+}
+
+// Counters returns the number of events delivered by the source so far, by type.
+// Events dropped by Notify (decoding failures, stale replays) are not counted;
+// see SkipStats.
+func (s *K8sNotificationSource) Counters() EventCounters {
+	return s.counters.snapshot(s.gvk)
+}
+
+// SkipStats returns the number of events dropped by Notify so far, by reason.
+// Reasons without drops are omitted.
+func (s *K8sNotificationSource) SkipStats() map[SkipReason]uint64 {
+	return s.skips.snapshot()
+}
+
 // OutputType returns the type of data this DataSource produces (NotificationEvent).
 func (s *K8sNotificationSource) OutputType() reflect.Type {
 	return fwkdl.NotificationEventType
@@ -71,5 +467,46 @@ func (s *K8sNotificationSource) ExtractorType() reflect.Type {
 // Returns the event (possibly modified) for Runtime to dispatch to extractors.
 // Returns nil event to signal Runtime to skip extractor dispatch.
 func (s *K8sNotificationSource) Notify(ctx context.Context, event fwkdl.NotificationEvent) (*fwkdl.NotificationEvent, error) {
+	// Extractors rely on the object, if only for the name of deleted ones.
+	if event.Object == nil {
+		log.FromContext(ctx).Info("dropping notification without an object", "source", s.typedName, "eventType", event.Type)
+		s.skips.add(SkipNilObject, 1)
+		return nil, nil
+	}
+	if s.drain.draining.Load() && ctx.Value(redeliveryKey{}) == nil {
+		s.skips.add(SkipDraining, 1)
+		return nil, nil
+	}
+	if held, dropped := s.pause.hold(ctx, event); held {
+		if dropped {
+			s.skips.add(SkipPaused, 1)
+		}
+		return nil, nil
+	}
+	if s.decoder != nil {
+		typed, err := s.decoder(event.Object)
+		if err != nil {
+			// retrying cannot decode the same object, so the event is dropped.
+			log.FromContext(ctx).Info("dropping notification that fails to decode", "source", s.typedName,
+				"eventType", event.Type, "namespace", event.Namespace(), "name", event.Name(), "error", err.Error())
+			s.skips.add(SkipUndecodable, 1)
+			return nil, nil
+		}
+		event.Typed = typed
+	}
+	if !s.watch.Matches(event) {
+		s.skips.add(SkipFiltered, 1)
+		return nil, nil
+	}
+	// record versions only once the event can be delivered, so failed events are retried.
+	if s.versions != nil && !s.versions.observe(event) {
+		s.skips.add(SkipStaleVersion, 1)
+		return nil, nil // stale replay
+	}
+	s.counters.observe(event)
+	s.forward(ctx, event)
+	if s.dryRun {
+		event.Meta.DryRun = true
+	}
 	return &event, nil
 }
@@ -19,15 +19,21 @@ package notifications
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	extractormocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/mocks"
 )
 
 var (
@@ -41,6 +47,73 @@ func TestNewK8sNotificationSource(t *testing.T) {
 	assert.Equal(t, testGVK, src.GVK())
 }
 
+func TestWithoutDeepCopy(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK)
+	assert.False(t, src.SkipDeepCopy(), "deep-copy should be enabled by default")
+
+	src = NewK8sNotificationSource(NotificationSourceType, "test", testGVK, WithoutDeepCopy())
+	assert.True(t, src.SkipDeepCopy())
+}
+
+func TestNewK8sNotificationSourceE(t *testing.T) {
+	a := extractormocks.NewNotificationExtractor("a")
+	b := extractormocks.NewNotificationExtractor("b")
+	src, err := NewK8sNotificationSourceE(NotificationSourceType, "test", testGVK, WithExtractors(a), WithExtractors(b))
+	require.NoError(t, err)
+	assert.Equal(t, []fwkdl.Extractor{a, b}, src.InitialExtractors())
+
+	tests := []struct {
+		name       string
+		extractors []fwkdl.Extractor
+		wantErr    string
+	}{
+		{name: "nil extractor", extractors: []fwkdl.Extractor{nil}, wantErr: "extractor 0 is nil"},
+		{name: "polling extractor", extractors: []fwkdl.Extractor{extractormocks.NewPollingExtractor("poll")},
+			wantErr: "is not a notification extractor"},
+		{name: "GVK mismatch", extractors: []fwkdl.Extractor{
+			extractormocks.NewNotificationExtractor("svc").WithGVK(schema.GroupVersionKind{Version: "v1", Kind: "Service"})},
+			wantErr: "does not match source GVK"},
+		{name: "duplicate name", extractors: []fwkdl.Extractor{a, extractormocks.NewNotificationExtractor("a")},
+			wantErr: `duplicate extractor name "a"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, err := NewK8sNotificationSourceE(NotificationSourceType, "test", testGVK, WithExtractors(tt.extractors...))
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Nil(t, src)
+		})
+	}
+}
+
+func TestHasSynced(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK)
+	assert.False(t, src.HasSynced(), "source should not be synced initially")
+
+	src.SetSynced()
+	assert.True(t, src.HasSynced())
+}
+
+func TestWaitForSync(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, src.WaitForSync(ctx), context.Canceled, "should not wait past the context")
+
+	waited := make(chan error)
+	go func() { waited <- src.WaitForSync(context.Background()) }()
+	src.SetSynced()
+	select {
+	case err := <-waited:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("WaitForSync did not return after SetSynced")
+	}
+
+	src.SetSynced() // idempotent
+	assert.NoError(t, src.WaitForSync(ctx), "a synced source should not wait, even with a done context")
+}
+
 func TestNotifyReturnsEvent(t *testing.T) {
 	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK)
 
@@ -75,6 +148,151 @@ func TestNotifyReturnsEvent(t *testing.T) {
 	assert.Equal(t, "test-cm", event.Object.GetName())
 }
 
+func TestNotifyDecodesObject(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK, WithDecoder(DecodeAs[corev1.Pod]()))
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(testGVK)
+	obj.SetName("test-pod")
+	obj.SetNamespace("default")
+	obj.SetLabels(map[string]string{"app": "vllm"})
+
+	event, err := src.Notify(context.Background(), fwkdl.NotificationEvent{
+		Type:   fwkdl.EventAddOrUpdate,
+		Object: obj,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, event)
+
+	pod, ok := fwkdl.TypedObject[*corev1.Pod](*event)
+	require.True(t, ok, "expected event to carry a decoded pod")
+	assert.Equal(t, "test-pod", pod.Name)
+	assert.Equal(t, "vllm", pod.Labels["app"])
+
+	// sources without a decoder leave Typed unset.
+	event, err = NewK8sNotificationSource(NotificationSourceType, "test", testGVK).Notify(context.Background(),
+		fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+	require.NoError(t, err)
+	assert.Nil(t, event.Typed)
+}
+
+func TestNotifyDropsUndecodableObject(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK,
+		WithDecoder(func(*unstructured.Unstructured) (runtime.Object, error) { return nil, errors.New("bad object") }))
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(testGVK)
+	obj.SetName("test-pod")
+	event, err := src.Notify(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+	require.NoError(t, err, "undecodable events must not be retried")
+	assert.Nil(t, event)
+	assert.Equal(t, map[SkipReason]uint64{SkipUndecodable: 1}, src.SkipStats())
+}
+
+func TestStaleEventFilter(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK, WithStaleEventFilter())
+	ctx := context.Background()
+
+	notify := func(eventType fwkdl.EventType, name, version string) bool {
+		obj := &unstructured.Unstructured{}
+		obj.SetName(name)
+		obj.SetNamespace("default")
+		obj.SetResourceVersion(version)
+		event, err := src.Notify(ctx, fwkdl.NotificationEvent{Type: eventType, Object: obj})
+		require.NoError(t, err)
+		return event != nil
+	}
+
+	assert.True(t, notify(fwkdl.EventAddOrUpdate, "pod-a", "20"))
+	assert.False(t, notify(fwkdl.EventAddOrUpdate, "pod-a", "10"), "older version should be dropped")
+	assert.False(t, notify(fwkdl.EventAddOrUpdate, "pod-a", "20"), "same version should be dropped")
+	assert.True(t, notify(fwkdl.EventAddOrUpdate, "pod-b", "10"), "versions are tracked per object")
+	assert.True(t, notify(fwkdl.EventAddOrUpdate, "pod-a", "21"))
+	assert.True(t, notify(fwkdl.EventAddOrUpdate, "pod-a", "not-a-number"), "unparsable versions are delivered")
+
+	assert.True(t, notify(fwkdl.EventDelete, "pod-a", ""), "deletes bypass the filter")
+	assert.True(t, notify(fwkdl.EventAddOrUpdate, "pod-a", "5"), "deleted objects are forgotten")
+
+	// without the filter, replays are delivered.
+	src = NewK8sNotificationSource(NotificationSourceType, "test", testGVK)
+	assert.True(t, notify(fwkdl.EventAddOrUpdate, "pod-a", "20"))
+	assert.True(t, notify(fwkdl.EventAddOrUpdate, "pod-a", "10"))
+}
+
+func TestCounters(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK, WithStaleEventFilter())
+	ctx := context.Background()
+
+	notify := func(eventType fwkdl.EventType, name, version string) {
+		obj := &unstructured.Unstructured{}
+		obj.SetName(name)
+		obj.SetNamespace("default")
+		obj.SetResourceVersion(version)
+		_, err := src.Notify(ctx, fwkdl.NotificationEvent{Type: eventType, Object: obj})
+		require.NoError(t, err)
+	}
+
+	notify(fwkdl.EventAddOrUpdate, "pod-a", "1")
+	notify(fwkdl.EventAddOrUpdate, "pod-b", "2")
+	notify(fwkdl.EventAddOrUpdate, "pod-a", "3")
+	notify(fwkdl.EventAddOrUpdate, "pod-a", "3") // stale replay, not counted
+	notify(fwkdl.EventDelete, "pod-a", "")
+	notify(fwkdl.EventAddOrUpdate, "pod-a", "4") // recreated
+
+	assert.Equal(t, EventCounters{GVK: testGVK, Adds: 3, Updates: 1, Deletes: 1}, src.Counters())
+}
+
+func TestSkipStats(t *testing.T) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK, WithStaleEventFilter(), WithPauseBuffer(1))
+	ctx := context.Background()
+	assert.Empty(t, src.SkipStats())
+
+	notify := func(version string) {
+		obj := &unstructured.Unstructured{}
+		obj.SetName("pod")
+		obj.SetNamespace("default")
+		obj.SetResourceVersion(version)
+		_, err := src.Notify(ctx, fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+		require.NoError(t, err)
+	}
+
+	notify("2")
+	notify("1") // stale
+	notify("2") // stale
+	src.Pause()
+	notify("3") // buffered
+	notify("4") // buffered, evicting version 3
+	notify("5") // buffered, evicting version 4
+	// the source is not bound, so the buffered version 5 is discarded.
+	require.NoError(t, src.Resume(ctx))
+
+	assert.Equal(t, map[SkipReason]uint64{SkipStaleVersion: 2, SkipPaused: 3}, src.SkipStats())
+	assert.EqualValues(t, 3, src.DroppedWhilePaused())
+}
+
+func TestEventFilter(t *testing.T) {
+	labeled := func(event fwkdl.NotificationEvent) bool { return event.Object.GetLabels()["app"] == "vllm" }
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK, WithEventFilter(fwkdl.Or(
+		labeled,
+		func(event fwkdl.NotificationEvent) bool { return event.Type == fwkdl.EventDelete },
+	)))
+	ctx := context.Background()
+	notify := func(eventType fwkdl.EventType, labels map[string]string) *fwkdl.NotificationEvent {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace("default")
+		obj.SetName("pod")
+		obj.SetLabels(labels)
+		processed, err := src.Notify(ctx, fwkdl.NotificationEvent{Type: eventType, Object: obj})
+		require.NoError(t, err)
+		return processed
+	}
+
+	assert.NotNil(t, notify(fwkdl.EventAddOrUpdate, map[string]string{"app": "vllm"}))
+	assert.Nil(t, notify(fwkdl.EventAddOrUpdate, map[string]string{"app": "other"}))
+	assert.NotNil(t, notify(fwkdl.EventDelete, nil))
+	assert.Equal(t, map[SkipReason]uint64{SkipFiltered: 1}, src.SkipStats())
+}
+
 func TestNotifyReturnsNilOnSkip(t *testing.T) {
 	// This tests the case where Notify might return nil to signal
 	// Runtime to skip extractor dispatch. Currently K8sNotificationSource
@@ -191,3 +409,82 @@ func TestNotificationSourceFactory(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchOptions(t *testing.T) {
+	opts := fwkdl.WatchOptions{
+		Namespaces:    []string{"default"},
+		LabelSelector: labels.SelectorFromSet(labels.Set{"app": "vllm"}),
+	}
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK, WithWatchOptions(opts))
+	ctx := context.Background()
+	notify := func(namespace string, objLabels map[string]string) *fwkdl.NotificationEvent {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace(namespace)
+		obj.SetName("pod")
+		obj.SetLabels(objLabels)
+		processed, err := src.Notify(ctx, fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj})
+		require.NoError(t, err)
+		return processed
+	}
+
+	assert.NotNil(t, notify("default", map[string]string{"app": "vllm"}))
+	assert.Nil(t, notify("other", map[string]string{"app": "vllm"}))
+	assert.Nil(t, notify("default", nil))
+	assert.Equal(t, map[SkipReason]uint64{SkipFiltered: 2}, src.SkipStats())
+
+	effective := src.WatchOptions()
+	assert.Equal(t, opts.Namespaces, effective.Namespaces)
+	assert.Equal(t, opts.LabelSelector.String(), effective.LabelSelector.String())
+	effective.Namespaces[0] = "other"
+	assert.Equal(t, []string{"default"}, src.WatchOptions().Namespaces, "the exposed options should be a copy")
+}
+
+func TestDryRun(t *testing.T) {
+	ctx := context.Background()
+	event := fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: &unstructured.Unstructured{}}
+
+	processed, err := NewK8sNotificationSource(NotificationSourceType, "test", testGVK, WithDryRun()).Notify(ctx, event)
+	require.NoError(t, err)
+	require.NotNil(t, processed)
+	assert.True(t, processed.Meta.DryRun)
+
+	processed, err = NewK8sNotificationSource(NotificationSourceType, "test", testGVK).Notify(ctx, event)
+	require.NoError(t, err)
+	require.NotNil(t, processed)
+	assert.False(t, processed.Meta.DryRun)
+}
+
+func TestResyncPeriod(t *testing.T) {
+	assert.Zero(t, NewK8sNotificationSource(NotificationSourceType, "test", testGVK).ResyncPeriod(), "resync should be disabled by default")
+
+	src, err := NewK8sNotificationSourceE(NotificationSourceType, "test", testGVK, WithResyncPeriod(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, src.ResyncPeriod())
+
+	_, err = NewK8sNotificationSourceE(NotificationSourceType, "test", testGVK, WithResyncPeriod(-time.Minute))
+	assert.ErrorContains(t, err, "negative resync period")
+}
+
+// discardAuditSink drops every audit record.
+type discardAuditSink struct{}
+
+func (discardAuditSink) RecordDispatch(context.Context, fwkdl.AuditRecord) {}
+
+func TestDispatchOptions(t *testing.T) {
+	assert.Zero(t, NewK8sNotificationSource(NotificationSourceType, "test", testGVK).DispatchOptions(),
+		"the core defaults should apply by default")
+
+	acked := false
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK,
+		WithDispatchStrategy(fwkdl.SequentialDispatch),
+		WithExtractorDeadline(time.Second),
+		WithAckFunc(func(fwkdl.NotificationEvent, fwkdl.DispatchOutcome) { acked = true }),
+		WithAuditSink(discardAuditSink{}))
+	opts := src.DispatchOptions()
+	assert.Equal(t, fwkdl.SequentialDispatch, opts.Strategy)
+	assert.Equal(t, time.Second, opts.ExtractorDeadline)
+	assert.Equal(t, discardAuditSink{}, opts.Audit)
+	require.NotNil(t, opts.Ack)
+	opts.Ack(fwkdl.NotificationEvent{}, fwkdl.DispatchSuccess)
+	assert.True(t, acked)
+}
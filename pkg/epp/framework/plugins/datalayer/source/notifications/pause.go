@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// pauseState holds back the events of a paused source. Events are dropped while
+// paused, unless a buffer size was set, in which case up to that many are kept,
+// dropping the oldest, and redelivered on resume.
+type pauseState struct {
+	mu        sync.Mutex
+	paused    bool
+	size      int // maximum number of buffered events; <= 0 drops all events
+	buffered  []heldEvent
+	redeliver func(ctx context.Context, event fwkdl.NotificationEvent) error // set by the core; nil until bound
+}
+
+// heldEvent is a buffered event, with the function completing it once it is
+// redelivered or dropped (see fwkdl.HoldEvent).
+type heldEvent struct {
+	event   fwkdl.NotificationEvent
+	release func()
+}
+
+type redeliveryKey struct{}
+
+// hold reports whether the event must be held back, buffering it if configured,
+// and whether an event was dropped as a result: this one, or the oldest buffered
+// one if the buffer is full. Redelivered events are never held back.
+func (p *pauseState) hold(ctx context.Context, event fwkdl.NotificationEvent) (held, dropped bool) {
+	if ctx.Value(redeliveryKey{}) != nil {
+		return false, false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.paused {
+		return false, false
+	}
+	if p.size <= 0 {
+		return true, true
+	}
+	if len(p.buffered) == p.size {
+		p.buffered[0].release()
+		p.buffered = p.buffered[1:]
+		dropped = true
+	}
+	p.buffered = append(p.buffered, heldEvent{event: event, release: fwkdl.HoldEvent(ctx)})
+	return true, dropped
+}
+
+// WithPauseBuffer makes a paused source buffer up to size events, instead of
+// dropping them, and redeliver them in order on Resume. When the buffer is full,
+// the oldest event is dropped. Buffered objects are snapshots taken when their
+// events fired.
+func WithPauseBuffer(size int) SourceOption {
+	return func(s *K8sNotificationSource) {
+		s.pause.size = size
+	}
+}
+
+// Pause stops the delivery of events to the source's extractors, e.g., during a
+// maintenance window, without tearing the source down. Events are dropped until
+// Resume, unless the source was created WithPauseBuffer. Pausing a paused source
+// has no effect.
+func (s *K8sNotificationSource) Pause() {
+	s.pause.mu.Lock()
+	defer s.pause.mu.Unlock()
+	s.pause.paused = true
+}
+
+// Resume redelivers the buffered events and restarts the delivery of new events.
+// Events firing while the buffered ones are redelivered are buffered as well, so
+// that all events are delivered in order. Returns the errors of the redelivered
+// events. Before the source is bound by the framework core, buffered events are
+// discarded.
+func (s *K8sNotificationSource) Resume(ctx context.Context) error {
+	ctx = context.WithValue(ctx, redeliveryKey{}, struct{}{})
+	var errs []error
+	for {
+		s.pause.mu.Lock()
+		batch, redeliver := s.pause.buffered, s.pause.redeliver
+		s.pause.buffered = nil
+		if len(batch) == 0 || redeliver == nil {
+			s.pause.paused = false
+			s.pause.mu.Unlock()
+			s.skips.add(SkipPaused, uint64(len(batch)))
+			for _, held := range batch {
+				held.release()
+			}
+			return errors.Join(errs...)
+		}
+		s.pause.mu.Unlock()
+
+		for _, held := range batch {
+			if err := redeliver(ctx, held.event); err != nil {
+				errs = append(errs, err)
+			}
+			held.release() // the redelivery processed the event, or journaled it again
+		}
+	}
+}
+
+// Paused reports whether the source is paused.
+func (s *K8sNotificationSource) Paused() bool {
+	s.pause.mu.Lock()
+	defer s.pause.mu.Unlock()
+	return s.pause.paused
+}
+
+// DroppedWhilePaused returns the number of events dropped while the source was
+// paused, including those evicted from a full buffer.
+func (s *K8sNotificationSource) DroppedWhilePaused() uint64 {
+	return s.SkipStats()[SkipPaused]
+}
+
+// SetRedeliver is called by the framework core when binding the source.
+func (s *K8sNotificationSource) SetRedeliver(redeliver func(ctx context.Context, event fwkdl.NotificationEvent) error) {
+	s.pause.mu.Lock()
+	defer s.pause.mu.Unlock()
+	s.pause.redeliver = redeliver
+}
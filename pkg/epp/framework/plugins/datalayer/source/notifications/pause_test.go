@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// pausedTestSource returns a source whose redelivered and directly delivered
+// events are recorded by name, as the framework core would dispatch them.
+func pausedTestSource(t *testing.T, opts ...SourceOption) (*K8sNotificationSource, func(string), func() []string) {
+	src := NewK8sNotificationSource(NotificationSourceType, "test", testGVK, opts...)
+	var mu sync.Mutex
+	var delivered []string
+	deliver := func(ctx context.Context, event fwkdl.NotificationEvent) error {
+		processed, err := src.Notify(ctx, event)
+		if err != nil || processed == nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		delivered = append(delivered, processed.Name())
+		return nil
+	}
+	src.SetRedeliver(deliver)
+
+	push := func(name string) {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace("default")
+		obj.SetName(name)
+		require.NoError(t, deliver(context.Background(), fwkdl.NotificationEvent{Type: fwkdl.EventAddOrUpdate, Object: obj}))
+	}
+	get := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), delivered...)
+	}
+	return src, push, get
+}
+
+func TestPauseDropsEvents(t *testing.T) {
+	src, push, delivered := pausedTestSource(t)
+
+	push("before")
+	src.Pause()
+	require.True(t, src.Paused())
+	push("dropped-1")
+	push("dropped-2")
+	require.NoError(t, src.Resume(context.Background()))
+	push("after")
+
+	assert.False(t, src.Paused())
+	assert.Equal(t, []string{"before", "after"}, delivered())
+	assert.EqualValues(t, 2, src.DroppedWhilePaused())
+}
+
+func TestPauseBuffersEvents(t *testing.T) {
+	src, push, delivered := pausedTestSource(t, WithPauseBuffer(2))
+
+	src.Pause()
+	push("evicted")
+	push("buffered-1")
+	push("buffered-2")
+	assert.Empty(t, delivered(), "no events should be delivered while paused")
+
+	require.NoError(t, src.Resume(context.Background()))
+	push("after")
+	assert.Equal(t, []string{"buffered-1", "buffered-2", "after"}, delivered(), "buffered events should be redelivered in order")
+	assert.EqualValues(t, 1, src.DroppedWhilePaused(), "the oldest event should be evicted from a full buffer")
+}
+
+func TestPauseConcurrentNotify(t *testing.T) {
+	src, push, delivered := pausedTestSource(t, WithPauseBuffer(1000))
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				if i%2 == 0 {
+					src.Pause()
+				} else {
+					_ = src.Resume(context.Background())
+				}
+				push("pod")
+			}
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, src.Resume(context.Background()))
+	assert.Len(t, delivered(), 500, "no event should be lost with a large enough buffer")
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"strconv"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// resourceVersionTracker records the highest resourceVersion processed per object
+// to detect replays of already processed versions (e.g., on informer reconnects).
+//
+// Kubernetes defines resourceVersions as opaque strings. In practice they are
+// integers and are compared as such; versions that don't parse are never
+// considered stale.
+type resourceVersionTracker struct {
+	mu       sync.Mutex
+	versions map[types.NamespacedName]uint64
+}
+
+func newResourceVersionTracker() *resourceVersionTracker {
+	return &resourceVersionTracker{versions: make(map[types.NamespacedName]uint64)}
+}
+
+// observe records the event and reports whether it should be dispatched. Deletes
+// are always dispatched and forget the object, so a recreated object is tracked
+// from scratch.
+func (t *resourceVersionTracker) observe(event fwkdl.NotificationEvent) bool {
+	key := types.NamespacedName{Namespace: event.Namespace(), Name: event.Name()}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if event.Type == fwkdl.EventDelete {
+		delete(t.versions, key)
+		return true
+	}
+
+	version, err := strconv.ParseUint(event.ResourceVersion(), 10, 64)
+	if err != nil {
+		return true
+	}
+	if last, seen := t.versions[key]; seen && version <= last {
+		return false
+	}
+	t.versions[key] = version
+	return true
+}
@@ -18,8 +18,11 @@ package metrics
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -463,7 +466,89 @@ var inferenceModelRewriteDecisionsTotal = prometheus.NewCounterVec(
 	[]string{"model_rewrite_name", "model_name", "target_model"},
 )
 
-var registerMetrics sync.Once
+// --- Deprecation Metrics ---
+var deprecatedFlagsUsed = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: inferenceExtension,
+		Name:      "deprecated_flags_used_total",
+		Help:      metricsutil.HelpMsgWithStability("Number of deprecated command-line flags set at startup.", compbasemetrics.ALPHA),
+	},
+	[]string{"flag"},
+)
+
+// --- Data Layer Metrics ---
+var datalayerNotificationEventAge = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Subsystem: inferenceExtension,
+		Name:      "datalayer_notification_event_age_seconds",
+		Help:      metricsutil.HelpMsgWithStability("Distribution of the time between the last recorded change of an object and the delivery of its notification to data layer extractors.", compbasemetrics.ALPHA),
+		Buckets: []float64{
+			0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0, 30.0, 60.0, 300.0,
+		},
+	},
+	[]string{"gvk"},
+)
+
+var datalayerNotificationExtractorsInFlight = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: inferenceExtension,
+		Name:      "datalayer_notification_extractors_in_flight",
+		Help:      metricsutil.HelpMsgWithStability("Number of data layer extractor invocations currently processing a notification event.", compbasemetrics.ALPHA),
+	},
+	[]string{"gvk"},
+)
+
+var datalayerNotificationDispatchOutcomes = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: inferenceExtension,
+		Name:      "datalayer_notification_dispatch_outcomes_total",
+		Help:      metricsutil.HelpMsgWithStability("Counter of data layer notification events by dispatch outcome.", compbasemetrics.ALPHA),
+	},
+	[]string{"gvk", "outcome"},
+)
+
+// DefaultDatalayerDispatchLatencyBuckets are the default buckets, in seconds, of
+// the notification dispatch latency histogram: 10us to 10s, as extractors range
+// from in-memory updates to remote calls.
+var DefaultDatalayerDispatchLatencyBuckets = []float64{
+	0.00001, 0.00005, 0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0,
+}
+
+var (
+	datalayerDispatchLatencyBuckets       = DefaultDatalayerDispatchLatencyBuckets
+	datalayerNotificationDispatchDuration = newDatalayerNotificationDispatchDuration(datalayerDispatchLatencyBuckets)
+)
+
+func newDatalayerNotificationDispatchDuration(buckets []float64) *prometheus.HistogramVec {
+	return prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferenceExtension,
+			Name:      "datalayer_notification_dispatch_duration_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of the time data layer extractors take to process a notification event.", compbasemetrics.ALPHA),
+			Buckets:   buckets,
+		},
+		[]string{"gvk"},
+	)
+}
+
+var (
+	registerMetrics sync.Once
+	registered      atomic.Bool // set by Register
+)
+
+// SetDatalayerDispatchLatencyBuckets sets the buckets, in seconds, of the
+// notification dispatch latency histogram. Changing them after Register fails.
+func SetDatalayerDispatchLatencyBuckets(buckets []float64) error {
+	if slices.Equal(buckets, datalayerDispatchLatencyBuckets) {
+		return nil
+	}
+	if registered.Load() {
+		return errors.New("dispatch latency buckets must be set before metrics are registered")
+	}
+	datalayerDispatchLatencyBuckets = slices.Clone(buckets)
+	datalayerNotificationDispatchDuration = newDatalayerNotificationDispatchDuration(datalayerDispatchLatencyBuckets)
+	return nil
+}
 
 // Register all metrics.
 func Register(customCollectors ...prometheus.Collector) {
@@ -511,9 +596,15 @@ func Register(customCollectors ...prometheus.Collector) {
 		metrics.Registry.MustRegister(flowControlPoolSaturation)
 		metrics.Registry.MustRegister(flowControlRequestEnqueueDuration)
 		metrics.Registry.MustRegister(inferenceModelRewriteDecisionsTotal)
+		metrics.Registry.MustRegister(datalayerNotificationEventAge)
+		metrics.Registry.MustRegister(datalayerNotificationDispatchDuration)
+		metrics.Registry.MustRegister(datalayerNotificationExtractorsInFlight)
+		metrics.Registry.MustRegister(datalayerNotificationDispatchOutcomes)
+		metrics.Registry.MustRegister(deprecatedFlagsUsed)
 		for _, collector := range customCollectors {
 			metrics.Registry.MustRegister(collector)
 		}
+		registered.Store(true)
 	})
 }
 
@@ -561,6 +652,11 @@ func Reset() {
 	flowControlPoolSaturation.Reset()
 	flowControlRequestEnqueueDuration.Reset()
 	inferenceModelRewriteDecisionsTotal.Reset()
+	datalayerNotificationEventAge.Reset()
+	datalayerNotificationDispatchDuration.Reset()
+	datalayerNotificationExtractorsInFlight.Reset()
+	datalayerNotificationDispatchOutcomes.Reset()
+	deprecatedFlagsUsed.Reset()
 }
 
 // RecordRequestCounter records the number of requests.
@@ -902,3 +998,32 @@ func RecordFlowControlPoolSaturation(inferencePool string, saturation float64) {
 func RecordInferenceModelRewriteDecision(modelRewriteName, modelName, targetModel string) {
 	inferenceModelRewriteDecisionsTotal.WithLabelValues(modelRewriteName, modelName, targetModel).Inc()
 }
+
+// RecordDeprecatedFlagUsed records that a deprecated command-line flag was set.
+func RecordDeprecatedFlagUsed(name string) {
+	deprecatedFlagsUsed.WithLabelValues(name).Inc()
+}
+
+// RecordNotificationDispatchDuration records the time extractors took to process
+// a notification event.
+func RecordNotificationDispatchDuration(gvk string, duration time.Duration) {
+	datalayerNotificationDispatchDuration.WithLabelValues(gvk).Observe(duration.Seconds())
+}
+
+// RecordNotificationDispatchOutcome counts a notification event by the outcome
+// of its dispatch, e.g., "partial-failure".
+func RecordNotificationDispatchOutcome(gvk, outcome string) {
+	datalayerNotificationDispatchOutcomes.WithLabelValues(gvk, outcome).Inc()
+}
+
+// RecordNotificationEventAge records the age of a notification event at delivery.
+func RecordNotificationEventAge(gvk string, age time.Duration) {
+	datalayerNotificationEventAge.WithLabelValues(gvk).Observe(age.Seconds())
+}
+
+// RecordNotificationExtractorsInFlight adds delta to the number of extractor
+// invocations processing a notification event: 1 when one starts, -1 when it
+// returns.
+func RecordNotificationExtractorsInFlight(gvk string, delta int) {
+	datalayerNotificationExtractorsInFlight.WithLabelValues(gvk).Add(float64(delta))
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -1302,3 +1303,32 @@ func TestInferenceModelRewriteDecisionsTotalMetric(t *testing.T) {
 		})
 	}
 }
+
+func TestDatalayerDispatchLatencyBuckets(t *testing.T) {
+	require.Error(t, SetDatalayerDispatchLatencyBuckets([]float64{1}), "buckets can't change once registered")
+	require.NoError(t, SetDatalayerDispatchLatencyBuckets(DefaultDatalayerDispatchLatencyBuckets), "setting the same buckets is a no-op")
+
+	registry := prometheus.NewRegistry()
+	original := datalayerNotificationDispatchDuration
+	datalayerNotificationDispatchDuration = newDatalayerNotificationDispatchDuration([]float64{0.001, 0.1})
+	t.Cleanup(func() { datalayerNotificationDispatchDuration = original })
+	registry.MustRegister(datalayerNotificationDispatchDuration)
+
+	RecordNotificationDispatchDuration("/v1, Kind=Pod", 500*time.Microsecond)
+	RecordNotificationDispatchDuration("/v1, Kind=Pod", 50*time.Millisecond)
+	RecordNotificationDispatchDuration("/v1, Kind=Pod", time.Second)
+
+	want := `
+# HELP inference_extension_datalayer_notification_dispatch_duration_seconds [ALPHA] Distribution of the time data layer extractors take to process a notification event.
+# TYPE inference_extension_datalayer_notification_dispatch_duration_seconds histogram
+inference_extension_datalayer_notification_dispatch_duration_seconds_bucket{gvk="/v1, Kind=Pod",le="0.001"} 1
+inference_extension_datalayer_notification_dispatch_duration_seconds_bucket{gvk="/v1, Kind=Pod",le="0.1"} 2
+inference_extension_datalayer_notification_dispatch_duration_seconds_bucket{gvk="/v1, Kind=Pod",le="+Inf"} 3
+inference_extension_datalayer_notification_dispatch_duration_seconds_sum{gvk="/v1, Kind=Pod"} 1.0505
+inference_extension_datalayer_notification_dispatch_duration_seconds_count{gvk="/v1, Kind=Pod"} 3
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(want),
+		"inference_extension_datalayer_notification_dispatch_duration_seconds"); err != nil {
+		t.Error(err)
+	}
+}
@@ -19,12 +19,14 @@ package server
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
 )
 
 const (
@@ -79,12 +81,19 @@ type Options struct {
 	EnableCertReload       bool   // Enables certificate reloading of the certificates specified in --cert-path.
 	SecureServing          bool   // Enables secure serving.
 	MetricsEndpointAuth    bool   // Enables authentication and authorization of the metrics endpoint.
+	// Histogram buckets, in seconds, of the data layer notification dispatch latency.
+	DatalayerDispatchLatencyBuckets []float64
 	//
 	// Configuration.
 	//
 	ConfigFile string // The path to the configuration file.
 	ConfigText string // The configuration specified as text, in lieu of a file.
 
+	// DeprecatedFlagHook, when set, is called by Complete once for every deprecated
+	// flag set on the command line (e.g., to count usage in metrics). pflag prints
+	// the deprecation warning regardless.
+	DeprecatedFlagHook func(name string)
+
 	// internal
 	fs *pflag.FlagSet // FlagSet used in AddFlags() and consulted in Validate()
 }
@@ -114,6 +123,7 @@ func NewOptions() *Options {
 		EnablePprof:                      true,
 		SecureServing:                    true,
 		MetricsEndpointAuth:              true,
+		DatalayerDispatchLatencyBuckets:  slices.Clone(metrics.DefaultDatalayerDispatchLatencyBuckets),
 	}
 }
 
@@ -188,6 +198,8 @@ func (opts *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&opts.SecureServing, "secure-serving", opts.SecureServing, "Enables secure serving.")
 	fs.BoolVar(&opts.MetricsEndpointAuth, "metrics-endpoint-auth", opts.MetricsEndpointAuth,
 		"Enables authentication and authorization of the metrics endpoint.")
+	fs.Float64SliceVar(&opts.DatalayerDispatchLatencyBuckets, "datalayer-dispatch-latency-buckets", opts.DatalayerDispatchLatencyBuckets,
+		"Histogram buckets, in seconds, of the data layer notification dispatch latency metric.")
 	fs.StringVar(&opts.ConfigFile, "config-file", opts.ConfigFile, "The path to the configuration file.")
 	fs.StringVar(&opts.ConfigText, "config-text", opts.ConfigText, "The configuration specified as text, in lieu of a file.")
 }
@@ -198,6 +210,14 @@ func (opts *Options) Complete() error {
 
 	opts.EndpointTargetPorts = removeDuplicatePorts(opts.EndpointTargetPorts)
 
+	if opts.DeprecatedFlagHook != nil && opts.fs != nil {
+		opts.fs.Visit(func(f *pflag.Flag) { // visits flags that were set
+			if f.Deprecated != "" {
+				opts.DeprecatedFlagHook(f.Name)
+			}
+		})
+	}
+
 	// Complete logging options.
 	return opts.LoggingOptions.Complete()
 }
@@ -225,6 +245,16 @@ func (opts *Options) Validate() error {
 			opts.ModelServerMetricsScheme, "model-server-metrics-scheme")
 	}
 
+	if len(opts.DatalayerDispatchLatencyBuckets) == 0 {
+		return fmt.Errorf("flag %q must have at least one bucket", "datalayer-dispatch-latency-buckets")
+	}
+	for i, bucket := range opts.DatalayerDispatchLatencyBuckets {
+		if bucket <= 0 || (i > 0 && bucket <= opts.DatalayerDispatchLatencyBuckets[i-1]) {
+			return fmt.Errorf("flag %q must be positive and strictly increasing, got %v",
+				"datalayer-dispatch-latency-buckets", opts.DatalayerDispatchLatencyBuckets)
+		}
+	}
+
 	// Validate deprecated metric flags are not explicitly set
 	deprecatedMetricFlags := []string{
 		"total-queued-requests-metric",
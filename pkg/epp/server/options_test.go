@@ -17,6 +17,7 @@ limitations under the License.
 package server
 
 import (
+	"io"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -120,3 +121,87 @@ func TestEndpointTargetPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestDeprecatedFlagHook(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "no deprecated flags",
+			args: []string{"--grpc-port", "9000"},
+			want: nil,
+		},
+		{
+			name: "each deprecated flag reported once",
+			args: []string{
+				"--model-server-metrics-path", "/stats",
+				"--model-server-metrics-port", "8000",
+				"--model-server-metrics-port", "8001",
+			},
+			want: []string{"model-server-metrics-path", "model-server-metrics-port"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet(tt.name, pflag.ContinueOnError)
+			fs.SetOutput(io.Discard) // silence deprecation warnings
+
+			var got []string
+			opts := NewOptions()
+			opts.DeprecatedFlagHook = func(name string) { got = append(got, name) }
+			opts.AddFlags(fs)
+
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+			if err := opts.Complete(); err != nil {
+				t.Fatalf("Complete failed unexpectedly with error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Reported deprecated flags mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDatalayerDispatchLatencyBuckets(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		expectError bool
+		want        []float64
+	}{
+		{name: "custom buckets", args: []string{"--datalayer-dispatch-latency-buckets", "0.001,0.01,1"}, want: []float64{0.001, 0.01, 1}},
+		{name: "unordered buckets", args: []string{"--datalayer-dispatch-latency-buckets", "0.01,0.001"}, expectError: true},
+		{name: "non-positive bucket", args: []string{"--datalayer-dispatch-latency-buckets", "0,1"}, expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet(tt.name, pflag.ContinueOnError)
+			opts := NewOptions()
+			opts.AddFlags(fs)
+
+			argv := append([]string{"--pool-name", "pool"}, tt.args...)
+			if err := fs.Parse(argv); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+			err := opts.Validate()
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected a validation error but got none.")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate failed unexpectedly with error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, opts.DatalayerDispatchLatencyBuckets); diff != "" {
+				t.Errorf("Buckets mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}